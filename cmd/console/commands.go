@@ -0,0 +1,387 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/config"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/events"
+	"github.com/nais/console/pkg/fixtures"
+	"github.com/nais/console/pkg/leader"
+	"github.com/nais/console/pkg/legacy"
+	"github.com/nais/console/pkg/reconcilers"
+	console_reconciler "github.com/nais/console/pkg/reconcilers/console"
+	"github.com/nais/console/pkg/usersync"
+	log "github.com/sirupsen/logrus"
+)
+
+const serveCommand = "serve"
+
+type command struct {
+	usage       string
+	description string
+	run         func(ctx context.Context, cfg *config.Config, args []string) error
+}
+
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		serveCommand: {
+			usage:       "serve",
+			description: "Start the API server and the reconcile loop. This is the default command.",
+			run:         serve,
+		},
+		"migrate": {
+			usage:       "migrate [up | down [steps] | status]",
+			description: "Migrate the database schema. Defaults to applying all pending migrations.",
+			run:         migrateCommand,
+		},
+		"seed": {
+			usage:       "seed",
+			description: "Synchronize roles and authorizations, and insert the initial dataset into an empty database.",
+			run:         seedCommand,
+		},
+		"sync-team": {
+			usage:       "sync-team <slug>",
+			description: "Run all enabled reconcilers for a single team, or hand the team to the leader if one is running.",
+			run:         syncTeamCommand,
+		},
+		"sync-users": {
+			usage:       "sync-users",
+			description: "Synchronize users from the tenant directory. Refuses to run while a leader is running.",
+			run:         syncUsersCommand,
+		},
+		"create-api-key": {
+			usage:       "create-api-key <email>",
+			description: "Create an API key for a user, replacing any existing API key. The key is written to stdout.",
+			run:         createAPIKeyCommand,
+		},
+		"export-audit-logs": {
+			usage:       "export-audit-logs [-since RFC3339] [-until RFC3339] [-format ndjson|csv]",
+			description: "Write audit log entries to stdout as newline delimited JSON or CSV.",
+			run:         exportAuditLogsCommand,
+		},
+		"verify-audit-logs": {
+			usage:       "verify-audit-logs",
+			description: "Verify the hash chain of the audit log, and report missing or modified entries.",
+			run:         verifyAuditLogsCommand,
+		},
+		"import-legacy": {
+			usage:       "import-legacy -yaml <teams.yml> -json <teams.json>",
+			description: "Import teams and members from the legacy team files and Azure AD.",
+			run:         importLegacyCommand,
+		},
+	}
+}
+
+func printUsage() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(os.Stderr, "Usage: console [command] [arguments]\n\nCommands:\n")
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-55s %s\n", commands[name].usage, commands[name].description)
+	}
+}
+
+func migrateCommand(_ context.Context, cfg *config.Config, args []string) error {
+	direction := "up"
+	if len(args) > 0 {
+		direction, args = args[0], args[1:]
+	}
+
+	db, err := connectDatabase(cfg)
+	if err != nil {
+		return err
+	}
+
+	migrator, err := dbmodels.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	switch direction {
+	case "up":
+		return migrator.Up()
+	case "down":
+		steps := 1
+		if len(args) > 0 {
+			steps, err = strconv.Atoi(args[0])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("steps must be a positive integer")
+			}
+		}
+		return migrator.Down(steps)
+	case "status":
+		status, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+		for _, migration := range status {
+			applied := "pending"
+			if migration.AppliedAt != nil {
+				applied = "applied " + migration.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%04d %-40s %s\n", migration.Version, migration.Name, applied)
+		}
+		return nil
+	default:
+		return fmt.Errorf("usage: console %s", commands["migrate"].usage)
+	}
+}
+
+func seedCommand(_ context.Context, cfg *config.Config, _ []string) error {
+	db, err := connectDatabase(cfg)
+	if err != nil {
+		return err
+	}
+
+	return setupFixtures(db, cfg)
+}
+
+func syncTeamCommand(ctx context.Context, cfg *config.Config, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: console %s", commands["sync-team"].usage)
+	}
+
+	db, err := connectDatabase(cfg)
+	if err != nil {
+		return err
+	}
+
+	team := &dbmodels.Team{}
+	err = db.Where("slug = ?", args[0]).Preload("Users").Preload("Metadata").First(team).Error
+	if err != nil {
+		return fmt.Errorf("find team '%s': %w", args[0], err)
+	}
+
+	systems, err := fixtures.CreateReconcilerSystems(db)
+	if err != nil {
+		return err
+	}
+
+	corr := &dbmodels.Correlation{
+		Trigger:   dbmodels.CorrelationTriggerCommand,
+		Operation: "sync-team",
+	}
+	err = db.Create(corr).Error
+	if err != nil {
+		return fmt.Errorf("cannot create correlation entry for team sync: %w", err)
+	}
+
+	dispatcher, stopDispatcher, err := startAuditLogSinks(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer stopDispatcher()
+
+	publisher := events.NewPostgres(db, cfg.DatabaseURL)
+	logger := auditlogger.NewWithSinks(db, publisher, dispatcher)
+	logger.Logf(console_reconciler.OpSyncTeam, *corr, *systems[console_reconciler.Name], nil, team, nil, "Manual sync requested from the command line")
+
+	// Reconciling next to a running leader would race it, so the team is handed over to the leader instead
+	elector := leader.New(db)
+	leading, err := elector.Campaign(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !leading {
+		err = dbmodels.EnqueueReconcile(db, *corr.ID, *team.ID)
+		if err != nil {
+			return err
+		}
+
+		log.Infof("A leader is running, and will reconcile team '%s' shortly.", team.Slug)
+		return nil
+	}
+	defer elector.Resign(ctx)
+
+	recs, err := initReconcilers(db, cfg, logger, systems)
+	if err != nil {
+		return err
+	}
+
+	inputs := map[uuid.UUID]reconcilers.Input{
+		*team.ID: {
+			Corr: *corr,
+			Team: *team,
+		},
+	}
+
+	return reconcileTeams(ctx, nil, db, recs, publisher, nil, &inputs)
+}
+
+func syncUsersCommand(ctx context.Context, cfg *config.Config, _ []string) error {
+	db, err := connectDatabase(cfg)
+	if err != nil {
+		return err
+	}
+
+	// The leader synchronizes users periodically, and two syncs at once would race each other
+	elector := leader.New(db)
+	leading, err := elector.Campaign(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !leading {
+		return fmt.Errorf("a leader is running and synchronizes users; refusing to synchronize users at the same time")
+	}
+	defer elector.Resign(ctx)
+
+	systems, err := fixtures.CreateReconcilerSystems(db)
+	if err != nil {
+		return err
+	}
+
+	dispatcher, stopDispatcher, err := startAuditLogSinks(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer stopDispatcher()
+
+	userSyncer, err := usersync.NewFromConfig(cfg, db, *systems[console_reconciler.Name], auditlogger.NewWithSinks(db, nil, dispatcher))
+	if err != nil {
+		return err
+	}
+
+	return userSyncer.Sync(ctx)
+}
+
+func createAPIKeyCommand(_ context.Context, cfg *config.Config, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: console %s", commands["create-api-key"].usage)
+	}
+
+	db, err := connectDatabase(cfg)
+	if err != nil {
+		return err
+	}
+
+	user := dbmodels.GetUserByEmail(db, args[0])
+	if user == nil {
+		return fmt.Errorf("user with email '%s' does not exist", args[0])
+	}
+
+	key, err := dbmodels.CreateAPIKey(db, *user.ID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(key.APIKey)
+	return nil
+}
+
+func exportAuditLogsCommand(_ context.Context, cfg *config.Config, args []string) error {
+	var since, until, format string
+	flags := flag.NewFlagSet("export-audit-logs", flag.ContinueOnError)
+	flags.StringVar(&since, "since", "", "only export entries created at or after this time (RFC3339)")
+	flags.StringVar(&until, "until", "", "only export entries created before this time (RFC3339)")
+	flags.StringVar(&format, "format", string(auditlogger.ExportFormatNDJSON), "output format, ndjson or csv")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	exportFormat, err := auditlogger.ParseExportFormat(format)
+	if err != nil {
+		return err
+	}
+
+	var sinceTime, untilTime *time.Time
+	if since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return fmt.Errorf("parse -since: %w", err)
+		}
+		sinceTime = &t
+	}
+	if until != "" {
+		t, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			return fmt.Errorf("parse -until: %w", err)
+		}
+		untilTime = &t
+	}
+
+	db, err := connectDatabase(cfg)
+	if err != nil {
+		return err
+	}
+
+	return auditlogger.Export(db, os.Stdout, exportFormat, sinceTime, untilTime)
+}
+
+func verifyAuditLogsCommand(_ context.Context, cfg *config.Config, _ []string) error {
+	db, err := connectDatabase(cfg)
+	if err != nil {
+		return err
+	}
+
+	result, err := auditlogger.VerifyChain(db)
+	if err != nil {
+		return err
+	}
+
+	for _, problem := range result.Problems {
+		fmt.Printf("%d\t%s\t%s\t%s\n", problem.Sequence, problem.AuditLogID, problem.Kind, problem.Message)
+	}
+
+	if !result.Valid() {
+		return fmt.Errorf("audit log hash chain is broken: %d problem(s) found in %d entries", len(result.Problems), result.Entries)
+	}
+
+	log.Infof("Audit log hash chain is intact: %d entries, sequence %d to %d.", result.Entries, result.FirstSequence, result.LastSequence)
+	return nil
+}
+
+func importLegacyCommand(_ context.Context, cfg *config.Config, args []string) error {
+	var ymlPath, jsonPath string
+	flags := flag.NewFlagSet("import-legacy", flag.ContinueOnError)
+	flags.StringVar(&ymlPath, "yaml", "", "path to the legacy teams.yml file")
+	flags.StringVar(&jsonPath, "json", "", "path to the legacy teams.json file containing the Azure AD group mapping")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if ymlPath == "" || jsonPath == "" {
+		return fmt.Errorf("usage: console %s", commands["import-legacy"].usage)
+	}
+
+	db, err := connectDatabase(cfg)
+	if err != nil {
+		return err
+	}
+
+	gimp, err := legacy.NewFromConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	teams, err := legacy.ReadTeamFiles(ymlPath, jsonPath)
+	if err != nil {
+		return err
+	}
+
+	imported, err := legacy.Import(db, gimp, teams, cfg.TenantDomain)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Imported %d teams.", len(imported))
+	return nil
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	console_reconciler "github.com/nais/console/pkg/reconcilers/console"
@@ -9,40 +10,53 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	graphql_handler "github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/go-chi/chi"
 	"github.com/go-chi/cors"
+	"github.com/gorilla/websocket"
 	"github.com/nais/console/pkg/auditlogger"
 	"github.com/nais/console/pkg/authn"
 	"github.com/nais/console/pkg/config"
 	"github.com/nais/console/pkg/dbmodels"
 	"github.com/nais/console/pkg/directives"
+	"github.com/nais/console/pkg/drift"
+	"github.com/nais/console/pkg/events"
 	"github.com/nais/console/pkg/fixtures"
 	"github.com/nais/console/pkg/graph"
 	"github.com/nais/console/pkg/graph/generated"
+	"github.com/nais/console/pkg/health"
+	"github.com/nais/console/pkg/leader"
 	"github.com/nais/console/pkg/middleware"
+	"github.com/nais/console/pkg/notifications"
 	"github.com/nais/console/pkg/reconcilers"
 	"github.com/nais/console/pkg/reconcilers/registry"
+	"github.com/nais/console/pkg/resync"
+	"github.com/nais/console/pkg/retention"
 	"github.com/nais/console/pkg/usersync"
 	"github.com/nais/console/pkg/version"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
 func main() {
-	err := run()
+	err := run(os.Args[1:])
 	if err != nil {
 		log.Errorf("fatal: %s", err)
 		os.Exit(1)
 	}
 }
 
-func run() error {
+func run(args []string) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -60,6 +74,42 @@ func run() error {
 		return err
 	}
 
+	name := serveCommand
+	if len(args) > 0 {
+		name, args = args[0], args[1:]
+	}
+
+	cmd, exists := commands[name]
+	if !exists {
+		printUsage()
+		return fmt.Errorf("unknown command '%s'", name)
+	}
+
+	return cmd.run(ctx, cfg, args)
+}
+
+// serve Run the API server, and take part in the leader election. The leader runs the reconcile loop, the user
+// synchronizer and the other scheduled jobs. This is the default command.
+//
+// On SIGTERM or SIGINT, no new work is started. Reconciles that are in flight are allowed to finish within the shutdown
+// timeout, after which they are aborted. Teams that are still pending are handed over to the next leader, and the HTTP
+// server is shut down gracefully before exiting.
+func serve(ctx context.Context, cfg *config.Config, _ []string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// In-flight work runs with workCtx, which outlives ctx by at most the shutdown timeout
+	workCtx, abortWork := context.WithCancel(context.Background())
+	defer abortWork()
+
+	var shutdownOnce sync.Once
+	shutdown := func() {
+		shutdownOnce.Do(func() {
+			cancel()
+			time.AfterFunc(cfg.ShutdownTimeout, abortWork)
+		})
+	}
+
 	db, err := setupDatabase(cfg)
 	if err != nil {
 		return err
@@ -70,15 +120,24 @@ func run() error {
 		return err
 	}
 
-	err = fixtures.InsertInitialDataset(db, cfg.TenantDomain, cfg.AdminApiKey)
+	err = setupFixtures(db, cfg)
 	if err != nil {
 		return err
 	}
 
+	broker := events.NewPostgres(db, cfg.DatabaseURL)
+	go broker.Run(ctx)
+
+	dispatcher, stopDispatcher, err := startAuditLogSinks(workCtx, cfg)
+	if err != nil {
+		return err
+	}
+	defer stopDispatcher()
+
 	// Control channels for goroutine communication
 	const maxQueueSize = 4096
 	teamReconciler := make(chan reconcilers.Input, maxQueueSize)
-	logger := auditlogger.New(db)
+	logger := auditlogger.NewWithSinks(db, broker, dispatcher)
 
 	recs, err := initReconcilers(db, cfg, logger, systems)
 	if err != nil {
@@ -87,13 +146,28 @@ func run() error {
 
 	log.Infof("Initialized %d reconcilers.", len(recs))
 
+	notifier, err := notifications.NewFromConfig(cfg, db)
+	if err != nil {
+		if err != notifications.ErrNotEnabled {
+			return err
+		}
+
+		log.Warnf("Team notifications disabled: %s", err)
+	}
+
 	store := authn.NewStore()
 	authHandler, err := setupAuthHandler(cfg, store)
 	if err != nil {
 		return err
 	}
-	handler := setupGraphAPI(db, cfg.TenantDomain, systems[console_reconciler.Name], teamReconciler, logger)
-	srv, err := setupHTTPServer(cfg, db, handler, authHandler, store)
+	handler, err := setupGraphAPI(db, cfg, systems[console_reconciler.Name], teamReconciler, logger, broker, notifier)
+	if err != nil {
+		return err
+	}
+	mainLoopHeartbeat := health.NewHeartbeat()
+	userSyncHeartbeat := health.NewHeartbeat()
+	checker := setupHealthChecks(cfg, db, recs, mainLoopHeartbeat, userSyncHeartbeat)
+	srv, err := setupHTTPServer(cfg, db, handler, authHandler, store, checker)
 	if err != nil {
 		return err
 	}
@@ -106,15 +180,15 @@ func run() error {
 			log.Error(err)
 		}
 		log.Infof("HTTP server finished, terminating...")
-		cancel()
+		shutdown()
 	}()
 
 	signals := make(chan os.Signal, 1)
 	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
 	go func() {
 		sig := <-signals
-		log.Infof("Received signal %s, terminating...", sig)
-		cancel()
+		log.Infof("Received signal %s, shutting down within %s...", sig, cfg.ShutdownTimeout)
+		shutdown()
 	}()
 
 	const nextReconcileGracePeriod = 15 * time.Second
@@ -126,27 +200,23 @@ func run() error {
 
 	pendingTeams := make(map[uuid.UUID]reconcilers.Input)
 
-	// Reconcile all teams on startup. All will share the same correlation ID
-	corr := &dbmodels.Correlation{}
-	err = db.WithContext(ctx).Create(corr).Error
-	if err != nil {
-		return fmt.Errorf("cannot create correlation entry for initial reconcile loop: %w", err)
-	}
-
-	allTeams := make([]*dbmodels.Team, 0)
-	db.Preload("Users").Preload("Metadata").Find(&allTeams)
-	for _, team := range allTeams {
-		teamReconciler <- reconcilers.Input{
-			Corr: *corr,
-			Team: *team,
+	// scheduleReconcile Add a team to the pending teams, unless it is already pending
+	scheduleReconcile := func(input reconcilers.Input) {
+		if nextReconcile.Before(time.Now()) {
+			nextReconcile = time.Now().Add(immediateReconcile)
+			reconcileTimer.Reset(immediateReconcile)
+		}
+		if _, exists := pendingTeams[*input.Team.ID]; !exists {
+			log.Infof("Scheduling team '%s' for reconciliation in %s", input.Team.Slug, nextReconcile.Sub(time.Now()))
+			pendingTeams[*input.Team.ID] = input
 		}
 	}
 
 	// User synchronizer
 	userSyncTimer := time.NewTimer(1 * time.Second)
+	userSyncTimer.Stop()
 	userSyncer, err := usersync.NewFromConfig(cfg, db, *systems[console_reconciler.Name], logger)
 	if err != nil {
-		userSyncTimer.Stop()
 		if err != usersync.ErrNotEnabled {
 			return err
 		}
@@ -154,25 +224,200 @@ func run() error {
 		log.Warnf("User synchronization disabled: %s", err)
 	}
 
+	// Retention of audit logs and reconcile errors
+	const initialRetentionDelay = 1 * time.Minute
+	retentionTimer := time.NewTimer(initialRetentionDelay)
+	retentionTimer.Stop()
+	retainer, err := retention.NewFromConfig(cfg, db, *systems[console_reconciler.Name], logger)
+	if err != nil {
+		if err != retention.ErrNotEnabled {
+			return err
+		}
+
+		log.Warnf("Retention disabled: %s", err)
+	}
+
+	// Detection of drift in external systems
+	const initialDriftDelay = 10 * time.Minute
+	const driftScanTimeout = 30 * time.Minute
+	driftTimer := time.NewTimer(initialDriftDelay)
+	driftTimer.Stop()
+	// The scan runs outside the main loop, which is signalled through driftDone when the scan is complete
+	driftDone := make(chan struct{}, 1)
+	cancelDriftScan := func() {}
+	driftScanner, err := drift.NewFromConfig(cfg, db, recs)
+	if err != nil {
+		if err != drift.ErrNotEnabled {
+			return err
+		}
+
+		log.Warnf("Drift detection disabled: %s", err)
+	}
+
+	// Periodic full resync of all teams
+	resyncTimer := time.NewTimer(1 * time.Second)
+	resyncTimer.Stop()
+	resyncer, err := resync.NewFromConfig(cfg, db)
+	if err != nil {
+		if err != resync.ErrNotEnabled {
+			return err
+		}
+
+		log.Warnf("Periodic resync disabled: %s", err)
+	}
+
+	// Only the leader reconciles teams and runs the scheduled jobs. Every replica serves the API, and replicas that are
+	// not the leader hand reconcile requests over to the leader through the reconcile queue.
+	const leaderElectionInterval = 5 * time.Second
+	const reconcileQueueInterval = 5 * time.Second
+
+	elector := leader.New(db)
+	isLeader := false
+	campaigned := false
+	leaderTimer := time.NewTimer(0)
+	queueTimer := time.NewTimer(reconcileQueueInterval)
+	queueTimer.Stop()
+
+	startLeading := func() error {
+		log.Infof("This replica is now the leader.")
+
+		// Reconcile all teams when becoming the leader. All will share the same correlation ID
+		corr := &dbmodels.Correlation{
+			Trigger: dbmodels.CorrelationTriggerStartup,
+		}
+		err := db.WithContext(ctx).Create(corr).Error
+		if err != nil {
+			return fmt.Errorf("cannot create correlation entry for initial reconcile loop: %w", err)
+		}
+
+		allTeams := make([]*dbmodels.Team, 0)
+		db.Preload("Users").Preload("Metadata").Find(&allTeams)
+		for _, team := range allTeams {
+			scheduleReconcile(reconcilers.Input{
+				Corr: *corr,
+				Team: *team,
+			})
+		}
+
+		queueTimer.Reset(immediateReconcile)
+		if userSyncer != nil {
+			userSyncTimer.Reset(1 * time.Second)
+			userSyncHeartbeat.Start()
+		}
+		if retainer != nil {
+			retentionTimer.Reset(initialRetentionDelay)
+		}
+		if driftScanner != nil {
+			driftTimer.Reset(initialDriftDelay)
+		}
+		if resyncer != nil {
+			next := resyncer.Next(time.Now())
+			log.Infof("Next full resync at %s", next)
+			resyncTimer.Reset(time.Until(next))
+		}
+
+		return nil
+	}
+
+	// stopLeading Stop all jobs, and hand the pending teams over to the next leader
+	stopLeading := func() {
+		for _, timer := range []*time.Timer{reconcileTimer, queueTimer, userSyncTimer, retentionTimer, driftTimer, resyncTimer} {
+			stopTimer(timer)
+		}
+		userSyncHeartbeat.Stop()
+		cancelDriftScan()
+		nextReconcile = time.Time{}
+
+		for teamID, input := range pendingTeams {
+			err := dbmodels.EnqueueReconcile(db, *input.Corr.ID, teamID)
+			if err != nil {
+				log.Errorf("unable to hand over reconcile of team '%s' to the next leader: %s", input.Team.Slug, err)
+			}
+			delete(pendingTeams, teamID)
+		}
+	}
+
+	defer func() {
+		if !isLeader {
+			return
+		}
+
+		stopLeading()
+
+		const resignTimeout = 5 * time.Second
+		ctx, cancel := context.WithTimeout(context.Background(), resignTimeout)
+		defer cancel()
+
+		err := elector.Resign(ctx)
+		if err != nil {
+			log.Errorf("Unable to resign leadership: %s", err)
+			return
+		}
+		log.Infof("Resigned leadership.")
+	}()
+
+	mainLoopHeartbeat.Start()
 	for ctx.Err() == nil {
+		mainLoopHeartbeat.Beat()
+
 		select {
 		case <-ctx.Done():
 			break
 
+		case <-leaderTimer.C:
+			leading, err := elector.Campaign(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Errorf("Leader election: %s", err)
+			}
+
+			switch {
+			case leading && !isLeader:
+				isLeader = true
+				err = startLeading()
+				if err != nil {
+					return err
+				}
+			case !leading && isLeader:
+				isLeader = false
+				log.Warnf("This replica is no longer the leader.")
+				stopLeading()
+			case !leading && !campaigned:
+				log.Infof("Another replica is the leader; reconciles will be handed over to it.")
+			}
+
+			campaigned = true
+			leaderTimer.Reset(leaderElectionInterval)
+
 		case input := <-teamReconciler:
-			if nextReconcile.Before(time.Now()) {
-				nextReconcile = time.Now().Add(immediateReconcile)
-				reconcileTimer.Reset(immediateReconcile)
+			if isLeader {
+				scheduleReconcile(input)
+				break
+			}
+
+			err := dbmodels.EnqueueReconcile(db, *input.Corr.ID, *input.Team.ID)
+			if err != nil {
+				log.Errorf("unable to hand over reconcile of team '%s' to the leader: %s", input.Team.Slug, err)
+			}
+
+		case <-queueTimer.C:
+			entries, err := dbmodels.DequeueReconciles(db.WithContext(ctx))
+			if err != nil {
+				log.Error(err)
 			}
-			if _, exists := pendingTeams[*input.Team.ID]; !exists {
-				log.Infof("Scheduling team '%s' for reconciliation in %s", input.Team.Slug, nextReconcile.Sub(time.Now()))
-				pendingTeams[*input.Team.ID] = input
+
+			for _, entry := range entries {
+				scheduleReconcile(reconcilers.Input{
+					Corr: entry.Correlation,
+					Team: entry.Team,
+				})
 			}
 
+			queueTimer.Reset(reconcileQueueInterval)
+
 		case <-reconcileTimer.C:
 			log.Infof("Running reconcile of %d teams...", len(pendingTeams))
 
-			err = reconcileTeams(ctx, db, recs, &pendingTeams)
+			err = reconcileTeams(workCtx, ctx.Done(), db, recs, broker, notifier, &pendingTeams)
 
 			if err != nil {
 				log.Error(err)
@@ -194,34 +439,152 @@ func run() error {
 
 			if err != nil {
 				log.Error(err)
+			} else {
+				userSyncHeartbeat.Beat()
 			}
 
 			userSyncTimer.Reset(30 * time.Second)
 			log.Infof("User synchronization complete.")
+
+		case <-retentionTimer.C:
+			log.Infof("Starting retention...")
+
+			result, err := retainer.Run(ctx)
+			if err != nil {
+				log.Error(err)
+			} else {
+				log.Infof("Retention complete, purged %d audit log entries, %d reconcile errors, %d reconcile results and %d correlations.", result.AuditLogs, result.ReconcileErrors, result.ReconcileResults, result.Correlations)
+			}
+
+			retentionTimer.Reset(cfg.Retention.Interval)
+
+		case <-driftTimer.C:
+			log.Infof("Starting drift scan...")
+
+			driftCtx, cancel := context.WithTimeout(ctx, driftScanTimeout)
+			cancelDriftScan = cancel
+			go func(ctx context.Context) {
+				defer func() { driftDone <- struct{}{} }()
+				defer cancel()
+
+				result, err := driftScanner.Scan(ctx)
+				if err != nil {
+					log.Error(err)
+					return
+				}
+
+				log.Infof("Drift scan complete, found %d differences in %d teams, %d scans failed.", result.Findings, result.Teams, result.Errors)
+
+				// Corrections are handled like any other reconcile request, so they are handed over to the next
+				// leader if this replica loses the leadership in the meantime
+				for _, input := range result.Corrections {
+					select {
+					case teamReconciler <- input:
+					case <-ctx.Done():
+						log.Warnf("Drift scan canceled before all corrections were scheduled.")
+						return
+					}
+				}
+			}(driftCtx)
+
+		case <-driftDone:
+			if isLeader {
+				driftTimer.Reset(cfg.Drift.Interval)
+			}
+
+		case <-resyncTimer.C:
+			log.Infof("Starting full resync...")
+
+			teams, err := resyncer.Run(ctx, teamReconciler)
+			if err != nil {
+				log.Error(err)
+			} else {
+				log.Infof("Full resync started, %d teams will be scheduled for reconciliation within %s.", teams, cfg.Resync.Window)
+			}
+
+			next := resyncer.Next(time.Now())
+			log.Infof("Next full resync at %s", next)
+			resyncTimer.Reset(time.Until(next))
 		}
 	}
 
-	log.Infof("Main program context canceled; exiting.")
+	log.Infof("Main program context canceled; shutting down...")
+
+	err = srv.Shutdown(workCtx)
+	if err != nil {
+		log.Errorf("Unable to shut down HTTP server gracefully: %s", err)
+	}
+
+	if notifier != nil {
+		notifier.Wait()
+	}
+
+	// Teams queued while shutting down are handed over to the next leader along with the pending teams
+	for drained := false; !drained; {
+		select {
+		case input := <-teamReconciler:
+			if isLeader {
+				if _, exists := pendingTeams[*input.Team.ID]; !exists {
+					pendingTeams[*input.Team.ID] = input
+				}
+				break
+			}
+
+			err := dbmodels.EnqueueReconcile(db, *input.Corr.ID, *input.Team.ID)
+			if err != nil {
+				log.Errorf("unable to hand over reconcile of team '%s' to the leader: %s", input.Team.Slug, err)
+			}
+		default:
+			drained = true
+		}
+	}
 
 	return nil
 }
 
-func reconcileTeams(ctx context.Context, db *gorm.DB, recs []reconcilers.Reconciler, reconcileInputs *map[uuid.UUID]reconcilers.Input) error {
+// stopTimer Stop a timer, and drain its channel so a tick that has already fired is not received later
+func stopTimer(timer *time.Timer) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+}
+
+// reconcileTeams Run all reconcilers for the pending teams. Teams that are fully reconciled are removed from the map.
+// notifier may be nil, in which case teams are not notified about failures. Once stop is closed, the team being
+// reconciled is finished, and the remaining teams are left in the map.
+func reconcileTeams(ctx context.Context, stop <-chan struct{}, db *gorm.DB, recs []reconcilers.Reconciler, publisher events.Publisher, notifier notifications.Notifier, reconcileInputs *map[uuid.UUID]reconcilers.Input) error {
 	const reconcileTimeout = 15 * time.Minute
-	errors := 0
+	failures := 0
 
 	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
 	defer cancel()
 
 	for teamId, input := range *reconcileInputs {
+		select {
+		case <-stop:
+			log.Infof("Shutting down; %d teams are left for the next reconcile.", len(*reconcileInputs))
+			return nil
+		default:
+		}
+
 		teamErrors := 0
+		teamConflicts := 0
+		publishSyncEvent(ctx, publisher, events.TypeTeamSyncStarted, input, nil, "")
 
 		for _, reconciler := range recs {
 			name := reconciler.System().Name
 			log.Infof("Starting reconciler '%s' for team: '%s'", name, input.Team.Name)
+			publishSyncEvent(ctx, publisher, events.TypeTeamSyncStarted, input, reconciler.System().ID, "")
 			err := reconciler.Reconcile(ctx, input)
+			recordReconcileResult(db, input, reconciler.System(), err)
+			notifyReconcileResult(ctx, notifier, input, reconciler.System(), err)
 			if err != nil {
 				log.Error(err)
+				publishSyncEvent(ctx, publisher, events.TypeTeamSyncFailed, input, reconciler.System().ID, err.Error())
+				conflict := errors.Is(err, dbmodels.ErrReconcileConflict)
 				err = db.Create(&dbmodels.ReconcileError{
 					CorrelationID: *input.Corr.ID,
 					SystemID:      *reconciler.System().ID,
@@ -233,26 +596,127 @@ func reconcileTeams(ctx context.Context, db *gorm.DB, recs []reconcilers.Reconci
 					log.Warnf("unable to store reconcile error to database: %s", err)
 				}
 
+				// Conflicts need manual intervention, and are not retried
+				if conflict {
+					teamConflicts++
+					continue
+				}
+
 				teamErrors++
 				continue
 			}
 
 			log.Infof("Successfully finished reconciler '%s' for team: '%s'", name, input.Team.Name)
+			publishSyncEvent(ctx, publisher, events.TypeTeamSyncFinished, input, reconciler.System().ID, "")
 		}
 
 		if teamErrors == 0 {
 			delete(*reconcileInputs, teamId)
 		}
-		errors += teamErrors
+
+		if teamErrors+teamConflicts == 0 {
+			publishSyncEvent(ctx, publisher, events.TypeTeamSyncFinished, input, nil, "")
+		} else {
+			publishSyncEvent(ctx, publisher, events.TypeTeamSyncFailed, input, nil, fmt.Sprintf("%d of %d systems failed", teamErrors+teamConflicts, len(recs)))
+		}
+		failures += teamErrors
 	}
 
-	if errors > 0 {
-		return fmt.Errorf("%d error(s) occurred during reconcile", errors)
+	if failures > 0 {
+		return fmt.Errorf("%d error(s) occurred during reconcile", failures)
 	}
 
 	return nil
 }
 
+// recordReconcileResult Store the outcome of a reconciler for the team. Failing to store the outcome is not fatal to the
+// sync.
+func recordReconcileResult(db *gorm.DB, input reconcilers.Input, system dbmodels.System, reconcileErr error) {
+	err := dbmodels.SetReconcileResult(db, *input.Corr.ID, *system.ID, *input.Team.ID, reconcileErr)
+	if err != nil {
+		log.Warnf("unable to store reconcile result to database: %s", err)
+	}
+}
+
+// notifyReconcileResult Let the notifier know the outcome of a reconciler for the team. Failing to notify the team is
+// not fatal to the sync.
+func notifyReconcileResult(ctx context.Context, notifier notifications.Notifier, input reconcilers.Input, system dbmodels.System, reconcileErr error) {
+	if notifier == nil {
+		return
+	}
+
+	var err error
+	if reconcileErr != nil {
+		err = notifier.ReconcileFailed(ctx, input.Team, system, reconcileErr)
+	} else {
+		err = notifier.ReconcileSucceeded(ctx, input.Team, system)
+	}
+
+	if err != nil {
+		log.Warnf("unable to notify team '%s': %s", input.Team.Slug, err)
+	}
+}
+
+// publishSyncEvent Notify subscribers about the progress of a team sync. Failing to publish is not fatal to the sync.
+func publishSyncEvent(ctx context.Context, publisher events.Publisher, eventType events.Type, input reconcilers.Input, systemID *uuid.UUID, message string) {
+	err := publisher.Publish(ctx, events.Event{
+		Type:          eventType,
+		TeamID:        *input.Team.ID,
+		CorrelationID: *input.Corr.ID,
+		SystemID:      systemID,
+		Message:       message,
+		CreatedAt:     time.Now(),
+	})
+	if err != nil {
+		log.Warnf("unable to publish team sync event: %s", err)
+	}
+}
+
+// startAuditLogSinks Start delivering audit log entries to the configured sinks. The returned function stops the
+// delivery, and waits until queued entries have been drained. The dispatcher is nil when no sinks are configured.
+func startAuditLogSinks(ctx context.Context, cfg *config.Config) (*auditlogger.Dispatcher, func(), error) {
+	sinks := make([]auditlogger.Sink, 0)
+
+	if cfg.AuditLogSinks.WebhookURL != "" {
+		sinks = append(sinks, auditlogger.NewWebhookSink(cfg.AuditLogSinks.WebhookURL, cfg.AuditLogSinks.WebhookSecret))
+	}
+
+	if cfg.AuditLogSinks.File != "" {
+		sink, err := auditlogger.NewFileSink(cfg.AuditLogSinks.File)
+		if err != nil {
+			return nil, nil, fmt.Errorf("open audit log file: %w", err)
+		}
+		sinks = append(sinks, sink)
+	}
+
+	if cfg.AuditLogSinks.SyslogEnabled {
+		sink, err := auditlogger.NewSyslogSink(cfg.AuditLogSinks.SyslogNetwork, cfg.AuditLogSinks.SyslogAddress)
+		if err != nil {
+			return nil, nil, fmt.Errorf("connect to syslog: %w", err)
+		}
+		sinks = append(sinks, sink)
+	}
+
+	if len(sinks) == 0 {
+		return nil, func() {}, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	dispatcher := auditlogger.NewDispatcher(sinks, cfg.AuditLogSinks.BufferSize)
+	done := make(chan struct{})
+	go func() {
+		dispatcher.Run(ctx)
+		close(done)
+	}()
+
+	log.Infof("Delivering audit log entries to %d sink(s).", len(sinks))
+
+	return dispatcher, func() {
+		cancel()
+		<-done
+	}, nil
+}
+
 func setupAuthHandler(cfg *config.Config, store authn.SessionStore) (*authn.Handler, error) {
 	cf := authn.NewGoogle(cfg.OAuth.ClientID, cfg.OAuth.ClientSecret, cfg.OAuth.RedirectURL)
 	frontendURL, err := url.Parse(cfg.FrontendURL)
@@ -315,7 +779,8 @@ func initReconcilers(db *gorm.DB, cfg *config.Config, logger auditlogger.AuditLo
 	return recs, nil
 }
 
-func setupDatabase(cfg *config.Config) (*gorm.DB, error) {
+// connectDatabase Connect to the database without touching the schema
+func connectDatabase(cfg *config.Config) (*gorm.DB, error) {
 	log.Infof("Connecting to database...")
 	db, err := gorm.Open(postgres.Open(cfg.DatabaseURL), &gorm.Config{})
 	if err != nil {
@@ -323,10 +788,14 @@ func setupDatabase(cfg *config.Config) (*gorm.DB, error) {
 	}
 	log.Infof("Successfully connected to database.")
 
-	// uuid-ossp is needed for PostgreSQL to generate UUIDs as primary keys
-	err = db.Exec(`CREATE EXTENSION IF NOT EXISTS "uuid-ossp";`).Error
+	return db, nil
+}
+
+// setupDatabase Connect to the database and migrate the schema to the latest version
+func setupDatabase(cfg *config.Config) (*gorm.DB, error) {
+	db, err := connectDatabase(cfg)
 	if err != nil {
-		return nil, fmt.Errorf("install postgres uuid extension: %w", err)
+		return nil, err
 	}
 
 	log.Infof("Migrating database schema...")
@@ -336,22 +805,83 @@ func setupDatabase(cfg *config.Config) (*gorm.DB, error) {
 	}
 
 	log.Infof("Successfully migrated database schema.")
+
+	sealed, err := auditlogger.SealLegacyEntries(db)
+	if err != nil {
+		return nil, fmt.Errorf("add existing audit log entries to the hash chain: %w", err)
+	}
+	if sealed > 0 {
+		log.Infof("Added %d existing audit log entries to the hash chain.", sealed)
+	}
+
 	return db, nil
 }
 
-func setupGraphAPI(db *gorm.DB, domain string, console *dbmodels.System, teamReconciler chan<- reconcilers.Input, logger auditlogger.AuditLogger) *graphql_handler.Server {
-	resolver := graph.NewResolver(db, domain, console, teamReconciler, logger)
+// setupFixtures Synchronize the role catalogue, and insert the initial dataset if the database is empty
+func setupFixtures(db *gorm.DB, cfg *config.Config) error {
+	log.Infof("Synchronizing roles and authorizations...")
+	diff, err := fixtures.SyncRolesAndAuthorizations(db, cfg.RemoveStaleRoles)
+	if err != nil {
+		return fmt.Errorf("synchronize roles and authorizations: %w", err)
+	}
+	diff.Log()
+
+	return fixtures.InsertInitialDataset(db, cfg.TenantDomain, cfg.AdminApiKey)
+}
+
+func setupGraphAPI(db *gorm.DB, cfg *config.Config, console *dbmodels.System, teamReconciler chan<- reconcilers.Input, logger auditlogger.AuditLogger, subscriber events.Subscriber, notifier notifications.Notifier) (*graphql_handler.Server, error) {
+	frontendURL, err := url.Parse(cfg.FrontendURL)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := graph.NewResolver(db, cfg.TenantDomain, console, teamReconciler, logger, subscriber, notifier)
 	gc := generated.Config{}
 	gc.Resolvers = resolver
 	gc.Directives.Auth = directives.Auth(db)
 
-	handler := graphql_handler.NewDefaultServer(
+	// Same setup as graphql_handler.NewDefaultServer, except for the websocket transport
+	handler := graphql_handler.New(
 		generated.NewExecutableSchema(
 			gc,
 		),
 	)
+	handler.AddTransport(transport.Websocket{
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: websocketOriginChecker(*frontendURL),
+		},
+		InitFunc:              middleware.WebsocketInit(db),
+		KeepAlivePingInterval: 10 * time.Second,
+	})
+	handler.AddTransport(transport.Options{})
+	handler.AddTransport(transport.GET{})
+	handler.AddTransport(transport.POST{})
+	handler.AddTransport(transport.MultipartForm{})
+	handler.SetQueryCache(lru.New(1000))
+	handler.Use(extension.Introspection{})
+	handler.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New(100),
+	})
 	handler.SetErrorPresenter(graph.GetErrorPresenter())
-	return handler
+	return handler, nil
+}
+
+// websocketOriginChecker Only accept websocket connections from the frontend or from the API server itself, as
+// websocket connections are not covered by the CORS policy.
+func websocketOriginChecker(frontendURL url.URL) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+
+		u, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+
+		return u.Host == r.Host || (u.Scheme == frontendURL.Scheme && u.Host == frontendURL.Host)
+	}
 }
 
 func corsConfig() cors.Options {
@@ -371,10 +901,43 @@ func corsConfig() cors.Options {
 	}
 }
 
-func setupHTTPServer(cfg *config.Config, db *gorm.DB, graphApi *graphql_handler.Server, authHandler *authn.Handler, store authn.SessionStore) (*http.Server, error) {
+// setupHealthChecks Register the checks served on /readyz and /healthz/details. The database and the main loop decide
+// whether the replica is ready; the other checks only degrade the reported status.
+func setupHealthChecks(cfg *config.Config, db *gorm.DB, recs []reconcilers.Reconciler, mainLoop, userSync *health.Heartbeat) *health.Checker {
+	const checkTimeout = 5 * time.Second
+	const credentialsCacheTTL = 5 * time.Minute
+	const userSyncMaxAge = 5 * time.Minute
+
+	// The main loop is blocked while running a job, the longest of which is reconciling teams
+	const mainLoopMaxAge = 20 * time.Minute
+
+	checker := health.New(checkTimeout)
+	checker.Add("database", true, health.Database(db))
+	checker.Add("migrations", true, health.Migrations(db))
+	checker.Add("main-loop", true, mainLoop.Check(mainLoopMaxAge))
+
+	if cfg.UserSync.Enabled {
+		checker.Add("usersync", false, userSync.Check(userSyncMaxAge))
+	}
+
+	if cfg.Health.CheckCredentials {
+		for _, rec := range recs {
+			if credentialChecker, ok := rec.(reconcilers.CredentialChecker); ok {
+				checker.Add("credentials:"+rec.System().Name, false, health.Cached(health.Credentials(credentialChecker.CheckCredentials), credentialsCacheTTL))
+			}
+		}
+	}
+
+	return checker
+}
+
+func setupHTTPServer(cfg *config.Config, db *gorm.DB, graphApi *graphql_handler.Server, authHandler *authn.Handler, store authn.SessionStore, checker *health.Checker) (*http.Server, error) {
 	r := chi.NewRouter()
 
 	r.Get("/healthz", func(_ http.ResponseWriter, _ *http.Request) {})
+	r.Get("/healthz/details", checker.DetailsHandler())
+	r.Get("/readyz", checker.ReadinessHandler())
+	r.Get("/metrics", promhttp.Handler().ServeHTTP)
 
 	r.Get("/", playground.Handler("GraphQL playground", "/query"))
 
@@ -393,10 +956,11 @@ func setupHTTPServer(cfg *config.Config, db *gorm.DB, graphApi *graphql_handler.
 	}
 
 	// Append the role loader middleware after all possible authentication middlewares have been added
-	middlewares = append(middlewares, middleware.LoadUserRoles(db))
+	middlewares = append(middlewares, middleware.LoadUserRoles(db), middleware.DataLoaders(db))
 
 	r.Route("/query", func(r chi.Router) {
 		r.Use(middlewares...)
+		r.Get("/", graphApi.ServeHTTP)
 		r.Post("/", graphApi.ServeHTTP)
 	})
 
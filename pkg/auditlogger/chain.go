@@ -0,0 +1,282 @@
+package auditlogger
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgtype"
+	"github.com/nais/console/pkg/dbmodels"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// verifyBatchSize Number of entries read from the database at a time when verifying the chain
+const verifyBatchSize = 1000
+
+// chainLock Serialize appends to the hash chain within this process. The advisory lock does the same across processes.
+var chainLock sync.Mutex
+
+type ChainProblemKind string
+
+const (
+	// ChainProblemGap One or more entries are missing from the chain
+	ChainProblemGap ChainProblemKind = "gap"
+
+	// ChainProblemModified The content of an entry does not match its hash
+	ChainProblemModified ChainProblemKind = "modified"
+
+	// ChainProblemBrokenLink The previous hash of an entry does not match the hash of the entry before it
+	ChainProblemBrokenLink ChainProblemKind = "broken_link"
+)
+
+type ChainProblem struct {
+	Kind       ChainProblemKind
+	Sequence   int64
+	AuditLogID uuid.UUID
+	Message    string
+}
+
+// ChainVerification Result of walking the audit log hash chain
+type ChainVerification struct {
+	Entries       int
+	FirstSequence int64
+	LastSequence  int64
+	Problems      []ChainProblem
+}
+
+// Valid Check if the chain is intact
+func (v *ChainVerification) Valid() bool {
+	return len(v.Problems) == 0
+}
+
+// hashContent The content of an audit log entry covered by its hash. Changing this struct invalidates all existing
+// hashes.
+type hashContent struct {
+	Sequence       int64      `json:"sequence"`
+	PreviousHash   string     `json:"previous_hash"`
+	ID             uuid.UUID  `json:"id"`
+	CreatedAt      string     `json:"created_at"`
+	Action         string     `json:"action"`
+	Message        string     `json:"message"`
+	CorrelationID  uuid.UUID  `json:"correlation_id"`
+	TargetSystemID uuid.UUID  `json:"target_system_id"`
+	ActorID        *uuid.UUID `json:"actor_id"`
+	TargetTeamID   *uuid.UUID `json:"target_team_id"`
+	TargetUserID   *uuid.UUID `json:"target_user_id"`
+
+	// Details Canonical JSON of the details. Left out when empty, so entries created before details were introduced
+	// keep their hashes.
+	Details json.RawMessage `json:"details,omitempty"`
+}
+
+// Hash Compute the hash of an audit log entry, covering its content, position and the hash of the previous entry
+func Hash(entry *dbmodels.AuditLog) string {
+	content := hashContent{
+		Sequence:       entry.Sequence,
+		PreviousHash:   entry.PreviousHash,
+		CreatedAt:      chainTime(entry.CreatedAt).Format(time.RFC3339Nano),
+		Action:         entry.Action,
+		Message:        entry.Message,
+		CorrelationID:  entry.CorrelationID,
+		TargetSystemID: entry.TargetSystemID,
+		ActorID:        entry.ActorID,
+		TargetTeamID:   entry.TargetTeamID,
+		TargetUserID:   entry.TargetUserID,
+		Details:        canonicalDetails(entry.Details),
+	}
+	if entry.ID != nil {
+		content.ID = *entry.ID
+	}
+
+	// Marshalling a struct of plain values never fails
+	data, _ := json.Marshal(content)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// canonicalDetails Get the details as JSON with sorted keys and no whitespace. The database does not preserve the
+// formatting of jsonb values, so the details must be normalized to hash the same before and after a round trip. Returns
+// nil for empty details.
+func canonicalDetails(details pgtype.JSONB) json.RawMessage {
+	if details.Status != pgtype.Present {
+		return nil
+	}
+
+	var value interface{}
+	dec := json.NewDecoder(bytes.NewReader(details.Bytes))
+	dec.UseNumber()
+	err := dec.Decode(&value)
+	if err != nil {
+		// Hash invalid JSON as a string, it can't have been written by console
+		data, _ := json.Marshal(string(details.Bytes))
+		return data
+	}
+
+	if object, ok := value.(map[string]interface{}); ok && len(object) == 0 {
+		return nil
+	}
+
+	data, _ := json.Marshal(value)
+	return data
+}
+
+// chainTime Normalize a timestamp to the precision stored by the database, so the hash is the same before and after a
+// round trip
+func chainTime(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Microsecond)
+}
+
+// lockChain Run fn in a transaction while holding the chain lock
+func lockChain(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	chainLock.Lock()
+	defer chainLock.Unlock()
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if tx.Dialector.Name() == "postgres" {
+			err := tx.Exec("SELECT pg_advisory_xact_lock(?)", dbmodels.AuditLogChainLockID).Error
+			if err != nil {
+				return fmt.Errorf("acquire audit log chain lock: %w", err)
+			}
+		}
+		return fn(tx)
+	})
+}
+
+// lastEntry Get the entry at the end of the chain, or an empty entry if the chain is empty
+func lastEntry(tx *gorm.DB) (*dbmodels.AuditLog, error) {
+	entries := make([]*dbmodels.AuditLog, 0)
+	err := tx.Order("sequence DESC").Limit(1).Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return &dbmodels.AuditLog{}, nil
+	}
+	return entries[0], nil
+}
+
+// appendToChain Store an entry at the end of the hash chain. Sets the ID, creation time, sequence and hashes of the
+// entry.
+func appendToChain(db *gorm.DB, entry *dbmodels.AuditLog) error {
+	return lockChain(db, func(tx *gorm.DB) error {
+		previous, err := lastEntry(tx)
+		if err != nil {
+			return fmt.Errorf("find end of audit log chain: %w", err)
+		}
+
+		id := uuid.New()
+		entry.ID = &id
+		entry.CreatedAt = chainTime(time.Now())
+		entry.Sequence = previous.Sequence + 1
+		entry.PreviousHash = previous.Hash
+		entry.Hash = Hash(entry)
+
+		return tx.Omit(clause.Associations).Create(entry).Error
+	})
+}
+
+// SealLegacyEntries Compute hashes for entries that were created before the hash chain was introduced, in sequence
+// order. Returns the number of entries that were sealed.
+func SealLegacyEntries(db *gorm.DB) (int, error) {
+	sealed := 0
+	err := lockChain(db, func(tx *gorm.DB) error {
+		entries := make([]*dbmodels.AuditLog, 0)
+		err := tx.Where("hash = ?", "").Order("sequence ASC").Find(&entries).Error
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			previous := &dbmodels.AuditLog{}
+			err = tx.Where("sequence < ?", entry.Sequence).Order("sequence DESC").Limit(1).Find(previous).Error
+			if err != nil {
+				return err
+			}
+
+			entry.PreviousHash = previous.Hash
+			entry.Hash = Hash(entry)
+			err = tx.Model(entry).UpdateColumns(map[string]interface{}{
+				"previous_hash": entry.PreviousHash,
+				"hash":          entry.Hash,
+			}).Error
+			if err != nil {
+				return fmt.Errorf("seal audit log entry %s: %w", entry.ID, err)
+			}
+			sealed++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return sealed, nil
+}
+
+// VerifyChain Walk the hash chain from the oldest to the newest entry, and report gaps and entries that have been
+// modified. The chain may start after sequence number 1 if old entries have been purged, in which case the link to the
+// first remaining entry can not be verified.
+func VerifyChain(db *gorm.DB) (*ChainVerification, error) {
+	result := &ChainVerification{
+		Problems: make([]ChainProblem, 0),
+	}
+
+	var previous *dbmodels.AuditLog
+	entries := make([]*dbmodels.AuditLog, 0)
+	for {
+		query := db.Order("sequence ASC").Limit(verifyBatchSize)
+		if previous != nil {
+			query = query.Where("sequence > ?", previous.Sequence)
+		}
+
+		err := query.Find(&entries).Error
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			result.verify(previous, entry)
+			previous = entry
+		}
+
+		if len(entries) < verifyBatchSize {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// verify Check a single entry against the entry before it in the chain, if any
+func (v *ChainVerification) verify(previous, entry *dbmodels.AuditLog) {
+	problem := func(kind ChainProblemKind, format string, args ...interface{}) {
+		v.Problems = append(v.Problems, ChainProblem{
+			Kind:       kind,
+			Sequence:   entry.Sequence,
+			AuditLogID: *entry.ID,
+			Message:    fmt.Sprintf(format, args...),
+		})
+	}
+
+	v.Entries++
+	v.LastSequence = entry.Sequence
+
+	switch {
+	case previous == nil:
+		v.FirstSequence = entry.Sequence
+	case entry.Sequence != previous.Sequence+1:
+		problem(ChainProblemGap, "entries %d to %d are missing", previous.Sequence+1, entry.Sequence-1)
+	case entry.PreviousHash != previous.Hash:
+		problem(ChainProblemBrokenLink, "previous hash does not match the hash of entry %d", previous.Sequence)
+	}
+
+	if Hash(entry) != entry.Hash {
+		problem(ChainProblemModified, "content does not match the hash of the entry")
+	}
+}
@@ -0,0 +1,136 @@
+package auditlogger_test
+
+import (
+	"testing"
+
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func setupChain(t *testing.T, entries int) (*gorm.DB, []*dbmodels.AuditLog) {
+	db := test.GetTestDB()
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Team{}, &dbmodels.System{}, &dbmodels.Correlation{}, &dbmodels.AuditLog{})
+
+	system := &dbmodels.System{Name: "console"}
+	corr := &dbmodels.Correlation{}
+	db.Create(system)
+	db.Create(corr)
+
+	logger := auditlogger.New(db)
+	for i := 0; i < entries; i++ {
+		err := logger.Logf("action", *corr, *system, nil, nil, nil, "entry %d", i)
+		assert.NoError(t, err)
+	}
+
+	auditLogs := make([]*dbmodels.AuditLog, 0)
+	db.Order("sequence ASC").Find(&auditLogs)
+	return db, auditLogs
+}
+
+func TestVerifyChain(t *testing.T) {
+	t.Run("Intact chain", func(t *testing.T) {
+		db, entries := setupChain(t, 3)
+
+		assert.Equal(t, int64(1), entries[0].Sequence)
+		assert.Empty(t, entries[0].PreviousHash)
+		assert.Equal(t, entries[0].Hash, entries[1].PreviousHash)
+		assert.Equal(t, entries[1].Hash, entries[2].PreviousHash)
+
+		result, err := auditlogger.VerifyChain(db)
+		assert.NoError(t, err)
+		assert.True(t, result.Valid())
+		assert.Equal(t, 3, result.Entries)
+		assert.Equal(t, int64(1), result.FirstSequence)
+		assert.Equal(t, int64(3), result.LastSequence)
+	})
+
+	t.Run("Modified entry", func(t *testing.T) {
+		db, entries := setupChain(t, 3)
+		db.Model(entries[1]).UpdateColumn("message", "something else")
+
+		result, err := auditlogger.VerifyChain(db)
+		assert.NoError(t, err)
+		assert.False(t, result.Valid())
+		assert.Len(t, result.Problems, 1)
+		assert.Equal(t, auditlogger.ChainProblemModified, result.Problems[0].Kind)
+		assert.Equal(t, *entries[1].ID, result.Problems[0].AuditLogID)
+	})
+
+	t.Run("Missing entry", func(t *testing.T) {
+		db, entries := setupChain(t, 3)
+		db.Exec("DELETE FROM audit_logs WHERE id = ?", entries[1].ID)
+
+		result, err := auditlogger.VerifyChain(db)
+		assert.NoError(t, err)
+		assert.Len(t, result.Problems, 1)
+		assert.Equal(t, auditlogger.ChainProblemGap, result.Problems[0].Kind)
+		assert.Equal(t, int64(3), result.Problems[0].Sequence)
+	})
+
+	t.Run("Purged start of chain", func(t *testing.T) {
+		db, entries := setupChain(t, 3)
+		db.Exec("DELETE FROM audit_logs WHERE id = ?", entries[0].ID)
+
+		result, err := auditlogger.VerifyChain(db)
+		assert.NoError(t, err)
+		assert.True(t, result.Valid())
+		assert.Equal(t, int64(2), result.FirstSequence)
+	})
+
+	t.Run("Replaced entry", func(t *testing.T) {
+		db, entries := setupChain(t, 3)
+		forged := *entries[1]
+		forged.Message = "forged"
+		forged.PreviousHash = "forged"
+		forged.Hash = auditlogger.Hash(&forged)
+		db.Exec("UPDATE audit_logs SET message = ?, previous_hash = ?, hash = ? WHERE id = ?", forged.Message, forged.PreviousHash, forged.Hash, forged.ID)
+
+		result, err := auditlogger.VerifyChain(db)
+		assert.NoError(t, err)
+		assert.Len(t, result.Problems, 2)
+		assert.Equal(t, auditlogger.ChainProblemBrokenLink, result.Problems[0].Kind)
+		assert.Equal(t, int64(2), result.Problems[0].Sequence)
+		assert.Equal(t, auditlogger.ChainProblemBrokenLink, result.Problems[1].Kind)
+		assert.Equal(t, int64(3), result.Problems[1].Sequence)
+	})
+}
+
+func TestAuditLogCanNotBeDeleted(t *testing.T) {
+	db, entries := setupChain(t, 1)
+
+	err := db.Delete(entries[0]).Error
+	assert.ErrorIs(t, err, dbmodels.ErrAuditLogImmutable)
+
+	err = db.Where("1 = 1").Delete(&dbmodels.AuditLog{}).Error
+	assert.ErrorIs(t, err, dbmodels.ErrAuditLogImmutable)
+
+	count := int64(0)
+	db.Model(&dbmodels.AuditLog{}).Count(&count)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestSealLegacyEntries(t *testing.T) {
+	db, entries := setupChain(t, 3)
+	db.Exec("UPDATE audit_logs SET previous_hash = '', hash = '' WHERE sequence < 3")
+
+	sealed, err := auditlogger.SealLegacyEntries(db)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sealed)
+
+	result, err := auditlogger.VerifyChain(db)
+	assert.NoError(t, err)
+	assert.True(t, result.Valid())
+
+	sealed, err = auditlogger.SealLegacyEntries(db)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sealed)
+
+	resealed := &dbmodels.AuditLog{}
+	db.Where("id = ?", entries[0].ID).First(resealed)
+	assert.Equal(t, entries[0].Hash, resealed.Hash)
+}
@@ -0,0 +1,118 @@
+package auditlogger
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/nais/console/pkg/dbmodels"
+	"gorm.io/gorm"
+)
+
+type ExportFormat string
+
+const (
+	ExportFormatNDJSON ExportFormat = "ndjson"
+	ExportFormatCSV    ExportFormat = "csv"
+)
+
+// exportBatchSize Number of entries fetched from the database at a time when exporting
+const exportBatchSize = 500
+
+// ParseExportFormat Get the export format with the given name, ignoring case
+func ParseExportFormat(name string) (ExportFormat, error) {
+	switch format := ExportFormat(strings.ToLower(name)); format {
+	case ExportFormatNDJSON, ExportFormatCSV:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unsupported export format '%s'", name)
+	}
+}
+
+// Export Write audit log entries created in the time range to w, oldest entry first. since and until are optional, and
+// until is exclusive.
+func Export(db *gorm.DB, w io.Writer, format ExportFormat, since, until *time.Time) error {
+	timeRange := func(db *gorm.DB) *gorm.DB {
+		if since != nil {
+			db = db.Where("created_at >= ?", *since)
+		}
+		if until != nil {
+			db = db.Where("created_at < ?", *until)
+		}
+		return db
+	}
+
+	return ExportScoped(db, w, format, timeRange)
+}
+
+// ExportScoped Write the audit log entries matching the scopes to w, ordered by sequence number
+func ExportScoped(db *gorm.DB, w io.Writer, format ExportFormat, scopes ...func(*gorm.DB) *gorm.DB) error {
+	var write func(record Record) error
+	var flush func() error
+
+	switch format {
+	case ExportFormatNDJSON:
+		enc := json.NewEncoder(w)
+		write = func(record Record) error {
+			return enc.Encode(record)
+		}
+		flush = func() error {
+			return nil
+		}
+	case ExportFormatCSV:
+		cw := csv.NewWriter(w)
+		err := cw.Write(csvHeader)
+		if err != nil {
+			return err
+		}
+		write = func(record Record) error {
+			return cw.Write(record.csvRow())
+		}
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+	default:
+		return fmt.Errorf("unsupported export format '%s'", format)
+	}
+
+	unscoped := func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped()
+	}
+
+	// Batches are fetched by sequence number rather than with FindInBatches, which pages by primary key
+	var last int64
+	for {
+		auditLogs := make([]*dbmodels.AuditLog, 0)
+		err := db.Model(&dbmodels.AuditLog{}).
+			Scopes(scopes...).
+			Preload("Actor", unscoped).
+			Preload("TargetSystem", unscoped).
+			Preload("TargetTeam", unscoped).
+			Preload("TargetUser", unscoped).
+			Where("sequence > ?", last).
+			Order("sequence ASC").
+			Limit(exportBatchSize).
+			Find(&auditLogs).Error
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range auditLogs {
+			err = write(NewRecord(entry))
+			if err != nil {
+				return err
+			}
+			last = entry.Sequence
+		}
+
+		if len(auditLogs) < exportBatchSize {
+			break
+		}
+	}
+
+	return flush()
+}
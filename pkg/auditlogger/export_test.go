@@ -0,0 +1,83 @@
+package auditlogger_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExport(t *testing.T) {
+	db := test.GetTestDB()
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Team{}, &dbmodels.System{}, &dbmodels.Correlation{}, &dbmodels.AuditLog{})
+
+	system := &dbmodels.System{Name: "github:team"}
+	actor := &dbmodels.User{Name: "User", Email: "user@example.com"}
+	team := &dbmodels.Team{Slug: "team", Name: "Team"}
+	corr := &dbmodels.Correlation{}
+	db.Create(system)
+	db.Create(actor)
+	db.Create(team)
+	db.Create(corr)
+
+	now := time.Now()
+	for i, action := range []string{"first", "second", "third"} {
+		db.Create(&dbmodels.AuditLog{
+			Model:          dbmodels.Model{CreatedAt: now.Add(time.Duration(i) * time.Hour)},
+			ActorID:        actor.ID,
+			CorrelationID:  *corr.ID,
+			TargetSystemID: *system.ID,
+			TargetTeamID:   team.ID,
+			Action:         action,
+			Message:        "message, with comma",
+			Sequence:       int64(i + 1),
+		})
+	}
+
+	t.Run("NDJSON", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := auditlogger.Export(db, buf, auditlogger.ExportFormatNDJSON, nil, nil)
+		assert.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert.Len(t, lines, 3)
+
+		record := auditlogger.Record{}
+		assert.NoError(t, json.Unmarshal([]byte(lines[0]), &record))
+		assert.Equal(t, "first", record.Action)
+		assert.Equal(t, "github:team", record.TargetSystem)
+		assert.Equal(t, "user@example.com", record.ActorEmail)
+		assert.Equal(t, "team", record.TargetTeamSlug)
+	})
+
+	t.Run("CSV with time range", func(t *testing.T) {
+		since := now.Add(time.Hour)
+		until := now.Add(2 * time.Hour)
+		buf := &bytes.Buffer{}
+		err := auditlogger.Export(db, buf, auditlogger.ExportFormatCSV, &since, &until)
+		assert.NoError(t, err)
+
+		rows, err := csv.NewReader(buf).ReadAll()
+		assert.NoError(t, err)
+		assert.Len(t, rows, 2)
+		assert.Equal(t, "id", rows[0][0])
+		assert.Equal(t, "second", rows[1][2])
+		assert.Equal(t, "message, with comma", rows[1][3])
+	})
+
+	t.Run("Unsupported format", func(t *testing.T) {
+		_, err := auditlogger.ParseExportFormat("xml")
+		assert.Error(t, err)
+
+		format, err := auditlogger.ParseExportFormat("CSV")
+		assert.NoError(t, err)
+		assert.Equal(t, auditlogger.ExportFormatCSV, format)
+	})
+}
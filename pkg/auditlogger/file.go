@@ -0,0 +1,38 @@
+package auditlogger
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+type fileSink struct {
+	lock sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+// NewFileSink Create a sink that appends records as JSON Lines to a file. The file is created if it does not exist.
+func NewFileSink(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileSink{
+		file: file,
+		enc:  json.NewEncoder(file),
+	}, nil
+}
+
+func (s *fileSink) Name() string {
+	return "file:" + s.file.Name()
+}
+
+func (s *fileSink) Write(_ context.Context, record Record) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.enc.Encode(record)
+}
@@ -1,19 +1,29 @@
 package auditlogger
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/jackc/pgtype"
 	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/events"
+	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 )
 
 type auditLogger struct {
-	db *gorm.DB
+	db         *gorm.DB
+	publisher  events.Publisher
+	dispatcher *Dispatcher
 }
 
 type AuditLogger interface {
 	Logf(action string, corr dbmodels.Correlation, targetSystem dbmodels.System, actor *dbmodels.User, targetTeam *dbmodels.Team, targetUser *dbmodels.User, message string, messageArgs ...interface{}) error
+
+	// LogWithDetails Same as Logf, but also store structured details about the action. details must marshal to a
+	// JSON object, and is typically a struct defined alongside the action.
+	LogWithDetails(action string, corr dbmodels.Correlation, targetSystem dbmodels.System, actor *dbmodels.User, targetTeam *dbmodels.Team, targetUser *dbmodels.User, details interface{}, message string, messageArgs ...interface{}) error
 }
 
 func New(db *gorm.DB) AuditLogger {
@@ -22,7 +32,29 @@ func New(db *gorm.DB) AuditLogger {
 	}
 }
 
+// NewWithPublisher Create an audit logger that also publishes an event for each entry targeting a team
+func NewWithPublisher(db *gorm.DB, publisher events.Publisher) AuditLogger {
+	return &auditLogger{
+		db:        db,
+		publisher: publisher,
+	}
+}
+
+// NewWithSinks Create an audit logger that publishes events like NewWithPublisher, and also queues each entry for
+// delivery to the sinks of the dispatcher
+func NewWithSinks(db *gorm.DB, publisher events.Publisher, dispatcher *Dispatcher) AuditLogger {
+	return &auditLogger{
+		db:         db,
+		publisher:  publisher,
+		dispatcher: dispatcher,
+	}
+}
+
 func (l *auditLogger) Logf(action string, corr dbmodels.Correlation, targetSystem dbmodels.System, actor *dbmodels.User, targetTeam *dbmodels.Team, targetUser *dbmodels.User, message string, messageArgs ...interface{}) error {
+	return l.LogWithDetails(action, corr, targetSystem, actor, targetTeam, targetUser, nil, message, messageArgs...)
+}
+
+func (l *auditLogger) LogWithDetails(action string, corr dbmodels.Correlation, targetSystem dbmodels.System, actor *dbmodels.User, targetTeam *dbmodels.Team, targetUser *dbmodels.User, details interface{}, message string, messageArgs ...interface{}) error {
 	var actorId *uuid.UUID
 	var targetTeamId *uuid.UUID
 	var targetUserId *uuid.UUID
@@ -53,12 +85,41 @@ func (l *auditLogger) Logf(action string, corr dbmodels.Correlation, targetSyste
 		TargetUserID:   targetUserId,
 
 		Message: fmt.Sprintf(message, messageArgs...),
+		Details: pgtype.JSONB{Status: pgtype.Null},
 	}
-	err := l.db.Omit(clause.Associations).Create(logEntry).Error
+
+	if details != nil {
+		err := logEntry.Details.Set(details)
+		if err != nil {
+			return fmt.Errorf("encode audit log details: %w", err)
+		}
+		if !bytes.HasPrefix(logEntry.Details.Bytes, []byte("{")) {
+			return fmt.Errorf("audit log details must be a JSON object")
+		}
+	}
+	err := appendToChain(l.db, logEntry)
 	if err != nil {
 		return fmt.Errorf("store audit log line in database: %s", err)
 	}
 
 	logEntry.Log().Infof(logEntry.Message)
-	return err
+
+	if l.dispatcher != nil {
+		l.dispatcher.Dispatch(NewRecord(logEntry))
+	}
+
+	if l.publisher != nil && targetTeamId != nil {
+		err = l.publisher.Publish(context.Background(), events.Event{
+			Type:          events.TypeAuditLogCreated,
+			TeamID:        *targetTeamId,
+			CorrelationID: *corr.ID,
+			AuditLogID:    logEntry.ID,
+			CreatedAt:     logEntry.CreatedAt,
+		})
+		if err != nil {
+			log.Warnf("unable to publish audit log event: %s", err)
+		}
+	}
+
+	return nil
 }
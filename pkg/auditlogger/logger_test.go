@@ -0,0 +1,69 @@
+package auditlogger_test
+
+import (
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogWithDetails(t *testing.T) {
+	type memberDetails struct {
+		GroupEmail  string `json:"groupEmail"`
+		MemberEmail string `json:"memberEmail"`
+	}
+
+	t.Run("Details are stored and covered by the hash chain", func(t *testing.T) {
+		db, _ := setupChain(t, 0)
+		system := &dbmodels.System{}
+		corr := &dbmodels.Correlation{}
+		db.First(system)
+		db.First(corr)
+
+		logger := auditlogger.New(db)
+		details := memberDetails{GroupEmail: "group@example.com", MemberEmail: "user@example.com"}
+		err := logger.LogWithDetails("action", *corr, *system, nil, nil, nil, details, "added %s", "user@example.com")
+		assert.NoError(t, err)
+		err = logger.Logf("action", *corr, *system, nil, nil, nil, "no details")
+		assert.NoError(t, err)
+
+		entries := make([]*dbmodels.AuditLog, 0)
+		db.Order("sequence ASC").Find(&entries)
+		assert.Len(t, entries, 2)
+		assert.Equal(t, "added user@example.com", entries[0].Message)
+		assert.Equal(t, pgtype.Present, entries[0].Details.Status)
+		assert.NotEqual(t, pgtype.Present, entries[1].Details.Status)
+
+		stored := memberDetails{}
+		assert.NoError(t, entries[0].Details.AssignTo(&stored))
+		assert.Equal(t, details, stored)
+
+		result, err := auditlogger.VerifyChain(db)
+		assert.NoError(t, err)
+		assert.True(t, result.Valid())
+
+		db.Model(entries[0]).UpdateColumn("details", `{"groupEmail":"other@example.com","memberEmail":"user@example.com"}`)
+		result, err = auditlogger.VerifyChain(db)
+		assert.NoError(t, err)
+		assert.Len(t, result.Problems, 1)
+		assert.Equal(t, auditlogger.ChainProblemModified, result.Problems[0].Kind)
+	})
+
+	t.Run("Details must be an object", func(t *testing.T) {
+		db, _ := setupChain(t, 0)
+		system := &dbmodels.System{}
+		corr := &dbmodels.Correlation{}
+		db.First(system)
+		db.First(corr)
+
+		logger := auditlogger.New(db)
+		err := logger.LogWithDetails("action", *corr, *system, nil, nil, nil, []string{"not", "an", "object"}, "message")
+		assert.Error(t, err)
+
+		var count int64
+		db.Model(&dbmodels.AuditLog{}).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+}
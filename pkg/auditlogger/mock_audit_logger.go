@@ -29,6 +29,23 @@ func (_m *MockAuditLogger) Logf(action string, corr dbmodels.Correlation, target
 	return r0
 }
 
+// LogWithDetails provides a mock function with given fields: action, corr, targetSystem, actor, targetTeam, targetUser, details, message, messageArgs
+func (_m *MockAuditLogger) LogWithDetails(action string, corr dbmodels.Correlation, targetSystem dbmodels.System, actor *dbmodels.User, targetTeam *dbmodels.Team, targetUser *dbmodels.User, details interface{}, message string, messageArgs ...interface{}) error {
+	var _ca []interface{}
+	_ca = append(_ca, action, corr, targetSystem, actor, targetTeam, targetUser, details, message)
+	_ca = append(_ca, messageArgs...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, dbmodels.Correlation, dbmodels.System, *dbmodels.User, *dbmodels.Team, *dbmodels.User, interface{}, string, ...interface{}) error); ok {
+		r0 = rf(action, corr, targetSystem, actor, targetTeam, targetUser, details, message, messageArgs...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type NewMockAuditLoggerT interface {
 	mock.TestingT
 	Cleanup(func())
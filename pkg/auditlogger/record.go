@@ -0,0 +1,112 @@
+package auditlogger
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/dbmodels"
+)
+
+// Record Flat representation of an audit log entry, used when sending entries to sinks and when exporting entries
+type Record struct {
+	ID              uuid.UUID       `json:"id"`
+	CreatedAt       time.Time       `json:"created_at"`
+	Action          string          `json:"action"`
+	Message         string          `json:"message"`
+	CorrelationID   uuid.UUID       `json:"correlation_id"`
+	TargetSystem    string          `json:"target_system"`
+	ActorID         *uuid.UUID      `json:"actor_id,omitempty"`
+	ActorEmail      string          `json:"actor_email,omitempty"`
+	TargetTeamID    *uuid.UUID      `json:"target_team_id,omitempty"`
+	TargetTeamSlug  string          `json:"target_team_slug,omitempty"`
+	TargetUserID    *uuid.UUID      `json:"target_user_id,omitempty"`
+	TargetUserEmail string          `json:"target_user_email,omitempty"`
+	Details         json.RawMessage `json:"details,omitempty"`
+	Sequence        int64           `json:"sequence"`
+	Hash            string          `json:"hash"`
+}
+
+// NewRecord Create a record from an audit log entry. Associations that are not loaded are left out of the record, except
+// for their IDs.
+func NewRecord(entry *dbmodels.AuditLog) Record {
+	record := Record{
+		CreatedAt:     entry.CreatedAt,
+		Action:        entry.Action,
+		Message:       entry.Message,
+		CorrelationID: entry.CorrelationID,
+		TargetSystem:  entry.TargetSystem.Name,
+		ActorID:       entry.ActorID,
+		TargetTeamID:  entry.TargetTeamID,
+		TargetUserID:  entry.TargetUserID,
+		Sequence:      entry.Sequence,
+		Hash:          entry.Hash,
+		Details:       canonicalDetails(entry.Details),
+	}
+
+	if entry.ID != nil {
+		record.ID = *entry.ID
+	}
+
+	if entry.Actor != nil {
+		record.ActorEmail = entry.Actor.Email
+	}
+
+	if entry.TargetTeam != nil {
+		record.TargetTeamSlug = entry.TargetTeam.Slug.String()
+	}
+
+	if entry.TargetUser != nil {
+		record.TargetUserEmail = entry.TargetUser.Email
+	}
+
+	return record
+}
+
+// csvHeader Column names used when exporting records as CSV
+var csvHeader = []string{
+	"id",
+	"created_at",
+	"action",
+	"message",
+	"correlation_id",
+	"target_system",
+	"actor_id",
+	"actor_email",
+	"target_team_id",
+	"target_team_slug",
+	"target_user_id",
+	"target_user_email",
+	"details",
+	"sequence",
+	"hash",
+}
+
+// csvRow Get the values of the record in the same order as csvHeader
+func (r Record) csvRow() []string {
+	optionalID := func(id *uuid.UUID) string {
+		if id == nil {
+			return ""
+		}
+		return id.String()
+	}
+
+	return []string{
+		r.ID.String(),
+		r.CreatedAt.UTC().Format(time.RFC3339Nano),
+		r.Action,
+		r.Message,
+		r.CorrelationID.String(),
+		r.TargetSystem,
+		optionalID(r.ActorID),
+		r.ActorEmail,
+		optionalID(r.TargetTeamID),
+		r.TargetTeamSlug,
+		optionalID(r.TargetUserID),
+		r.TargetUserEmail,
+		string(r.Details),
+		strconv.FormatInt(r.Sequence, 10),
+		r.Hash,
+	}
+}
@@ -0,0 +1,138 @@
+package auditlogger
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Sink A destination outside of the database where audit log records are delivered, for instance a SIEM
+type Sink interface {
+	// Name Human readable name of the sink, used in logs
+	Name() string
+
+	// Write Deliver a single record to the sink
+	Write(ctx context.Context, record Record) error
+}
+
+const (
+	defaultMaxAttempts = 5
+	defaultBackoff     = 1 * time.Second
+	maxBackoff         = 30 * time.Second
+
+	// drainTimeout Maximum time spent delivering queued records after the dispatcher has been stopped
+	drainTimeout = 5 * time.Second
+)
+
+// Dispatcher Deliver audit log records to a set of sinks in the background. Each sink has its own buffered queue, so
+// a slow or unavailable sink never blocks the audit logger or the other sinks. Records are dropped when a queue is
+// full, or when a sink fails to accept a record after several attempts.
+type Dispatcher struct {
+	sinks       []Sink
+	queues      []chan Record
+	maxAttempts int
+	backoff     time.Duration
+}
+
+func NewDispatcher(sinks []Sink, bufferSize int) *Dispatcher {
+	queues := make([]chan Record, len(sinks))
+	for i := range queues {
+		queues[i] = make(chan Record, bufferSize)
+	}
+
+	return &Dispatcher{
+		sinks:       sinks,
+		queues:      queues,
+		maxAttempts: defaultMaxAttempts,
+		backoff:     defaultBackoff,
+	}
+}
+
+// Dispatch Queue a record for delivery to all sinks. Never blocks.
+func (d *Dispatcher) Dispatch(record Record) {
+	for i, sink := range d.sinks {
+		select {
+		case d.queues[i] <- record:
+		default:
+			log.Errorf("audit log sink '%s' is full, dropping audit log entry %s", sink.Name(), record.ID)
+		}
+	}
+}
+
+// Run Deliver queued records until the context is cancelled. Records still queued at that time are given one delivery
+// attempt each before Run returns.
+func (d *Dispatcher) Run(ctx context.Context) {
+	wg := sync.WaitGroup{}
+	for i := range d.sinks {
+		wg.Add(1)
+		go func(sink Sink, queue <-chan Record) {
+			defer wg.Done()
+			d.work(ctx, sink, queue)
+		}(d.sinks[i], d.queues[i])
+	}
+	wg.Wait()
+}
+
+func (d *Dispatcher) work(ctx context.Context, sink Sink, queue <-chan Record) {
+	for {
+		select {
+		case <-ctx.Done():
+			d.drain(sink, queue)
+			return
+		case record := <-queue:
+			d.deliver(ctx, sink, record)
+		}
+	}
+}
+
+// deliver Write a record to the sink, retrying with exponential backoff
+func (d *Dispatcher) deliver(ctx context.Context, sink Sink, record Record) {
+	backoff := d.backoff
+	for attempt := 1; ; attempt++ {
+		err := sink.Write(ctx, record)
+		if err == nil {
+			return
+		}
+
+		if attempt >= d.maxAttempts || ctx.Err() != nil {
+			log.Errorf("unable to deliver audit log entry %s to sink '%s' after %d attempt(s): %s", record.ID, sink.Name(), attempt, err)
+			return
+		}
+
+		log.Warnf("unable to deliver audit log entry %s to sink '%s', retrying in %s: %s", record.ID, sink.Name(), backoff, err)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// drain Make a single delivery attempt for each record left in the queue
+func (d *Dispatcher) drain(sink Sink, queue <-chan Record) {
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+
+	for {
+		select {
+		case record := <-queue:
+			if ctx.Err() != nil {
+				log.Errorf("audit log sink '%s' did not drain in time, dropping audit log entry %s", sink.Name(), record.ID)
+				continue
+			}
+			err := sink.Write(ctx, record)
+			if err != nil {
+				log.Errorf("unable to deliver audit log entry %s to sink '%s' on shutdown: %s", record.ID, sink.Name(), err)
+			}
+		default:
+			return
+		}
+	}
+}
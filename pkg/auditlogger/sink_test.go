@@ -0,0 +1,105 @@
+package auditlogger
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSink struct {
+	lock     sync.Mutex
+	failures int
+	calls    int
+	records  []Record
+}
+
+func (s *fakeSink) Name() string {
+	return "fake"
+}
+
+func (s *fakeSink) Write(_ context.Context, record Record) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.calls++
+	if s.calls <= s.failures {
+		return fmt.Errorf("unavailable")
+	}
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *fakeSink) delivered() []Record {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]Record{}, s.records...)
+}
+
+func TestDispatcher(t *testing.T) {
+	t.Run("Retries failed deliveries", func(t *testing.T) {
+		sink := &fakeSink{failures: 2}
+		dispatcher := NewDispatcher([]Sink{sink}, 10)
+		dispatcher.backoff = time.Millisecond
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			dispatcher.Run(ctx)
+			close(done)
+		}()
+
+		record := Record{ID: uuid.New()}
+		dispatcher.Dispatch(record)
+
+		assert.Eventually(t, func() bool {
+			return len(sink.delivered()) == 1
+		}, time.Second, time.Millisecond)
+		assert.Equal(t, record.ID, sink.delivered()[0].ID)
+
+		cancel()
+		<-done
+	})
+
+	t.Run("Gives up after max attempts", func(t *testing.T) {
+		sink := &fakeSink{failures: 100}
+		dispatcher := NewDispatcher([]Sink{sink}, 10)
+		dispatcher.backoff = time.Millisecond
+		dispatcher.maxAttempts = 3
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		dispatcher.deliver(ctx, sink, Record{ID: uuid.New()})
+		assert.Equal(t, 3, sink.calls)
+		assert.Empty(t, sink.delivered())
+	})
+
+	t.Run("Drops records when the queue is full", func(t *testing.T) {
+		slow := &fakeSink{}
+		dispatcher := NewDispatcher([]Sink{slow}, 2)
+
+		for i := 0; i < 5; i++ {
+			dispatcher.Dispatch(Record{ID: uuid.New()})
+		}
+		assert.Len(t, dispatcher.queues[0], 2)
+	})
+
+	t.Run("Drains queued records when stopped", func(t *testing.T) {
+		sink := &fakeSink{}
+		dispatcher := NewDispatcher([]Sink{sink}, 10)
+		for i := 0; i < 3; i++ {
+			dispatcher.Dispatch(Record{ID: uuid.New()})
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		dispatcher.Run(ctx)
+
+		assert.Len(t, sink.delivered(), 3)
+	})
+}
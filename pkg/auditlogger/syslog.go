@@ -0,0 +1,39 @@
+package auditlogger
+
+import (
+	"context"
+	"encoding/json"
+	"log/syslog"
+)
+
+const syslogTag = "console"
+
+type syslogSink struct {
+	writer *syslog.Writer
+}
+
+// NewSyslogSink Create a sink that sends records as JSON to syslog, using the auth facility. Leave network and address
+// empty to use the local syslog daemon.
+func NewSyslogSink(network, address string) (Sink, error) {
+	writer, err := syslog.Dial(network, address, syslog.LOG_INFO|syslog.LOG_AUTH, syslogTag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &syslogSink{
+		writer: writer,
+	}, nil
+}
+
+func (s *syslogSink) Name() string {
+	return "syslog"
+}
+
+func (s *syslogSink) Write(_ context.Context, record Record) error {
+	msg, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return s.writer.Info(string(msg))
+}
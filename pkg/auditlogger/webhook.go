@@ -0,0 +1,76 @@
+package auditlogger
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// SignatureHeader Header containing the HMAC-SHA256 signature of the request body, as "sha256=<hex digest>"
+const SignatureHeader = "X-Console-Signature"
+
+const webhookTimeout = 10 * time.Second
+
+type webhookSink struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+// NewWebhookSink Create a sink that posts each record as JSON to a URL. When secret is set, the body is signed with
+// HMAC-SHA256 so the receiver can verify that the request originates from console.
+func NewWebhookSink(url, secret string) Sink {
+	return &webhookSink{
+		url:    url,
+		secret: []byte(secret),
+		client: &http.Client{
+			Timeout: webhookTimeout,
+		},
+	}
+}
+
+func (s *webhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *webhookSink) Write(ctx context.Context, record Record) error {
+	body, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if len(s.secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(s.secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// Sign Get the signature of a webhook body, as sent in SignatureHeader
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,48 @@
+package auditlogger_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSink(t *testing.T) {
+	const secret = "secret"
+	record := auditlogger.Record{
+		ID:     uuid.New(),
+		Action: "github:team:create",
+	}
+
+	t.Run("Signed delivery", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(t, auditlogger.Sign([]byte(secret), body), r.Header.Get(auditlogger.SignatureHeader))
+
+			received := auditlogger.Record{}
+			assert.NoError(t, json.Unmarshal(body, &received))
+			assert.Equal(t, record.ID, received.ID)
+			assert.Equal(t, record.Action, received.Action)
+		}))
+		defer srv.Close()
+
+		sink := auditlogger.NewWebhookSink(srv.URL, secret)
+		assert.NoError(t, sink.Write(context.Background(), record))
+	})
+
+	t.Run("Error response", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		sink := auditlogger.NewWebhookSink(srv.URL, secret)
+		assert.Error(t, sink.Write(context.Background(), record))
+	})
+}
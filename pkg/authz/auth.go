@@ -2,7 +2,12 @@ package authz
 
 import (
 	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
 	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/roles"
 )
 
 const userContextKey = "user"
@@ -18,3 +23,48 @@ func UserFromContext(ctx context.Context) *dbmodels.User {
 	user, _ := ctx.Value(userContextKey).(*dbmodels.User)
 	return user
 }
+
+// ErrNotAuthorized Returned when the user lacks the authorization needed to perform an action
+var ErrNotAuthorized = errors.New("not authorized")
+
+// RequireGlobalAuthorization Make sure the user has the authorization through a role binding that is not limited to a
+// specific target. Requires that the role bindings of the user has been loaded.
+func RequireGlobalAuthorization(user *dbmodels.User, authorization roles.Authorization) error {
+	if user == nil {
+		return ErrNotAuthorized
+	}
+
+	for _, binding := range user.RoleBindings {
+		if binding.TargetID != nil {
+			continue
+		}
+		for _, auth := range binding.Role.Authorizations {
+			if auth.Name == string(authorization) {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("%w: missing authorization '%s'", ErrNotAuthorized, authorization)
+}
+
+// RequireTeamAuthorization Make sure the user has the authorization, either through a global role binding or a role
+// binding targeting the team. Requires that the role bindings of the user has been loaded.
+func RequireTeamAuthorization(user *dbmodels.User, authorization roles.Authorization, teamID uuid.UUID) error {
+	if user == nil {
+		return ErrNotAuthorized
+	}
+
+	for _, binding := range user.RoleBindings {
+		if binding.TargetID != nil && *binding.TargetID != teamID {
+			continue
+		}
+		for _, auth := range binding.Role.Authorizations {
+			if auth.Name == string(authorization) {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("%w: missing authorization '%s' for team", ErrNotAuthorized, authorization)
+}
@@ -2,8 +2,10 @@ package authz_test
 
 import (
 	"context"
+	"github.com/google/uuid"
 	"github.com/nais/console/pkg/authz"
 	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/roles"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -20,3 +22,57 @@ func TestContextWithUser(t *testing.T) {
 	ctx = authz.ContextWithUser(ctx, user)
 	assert.Equal(t, user, authz.UserFromContext(ctx))
 }
+
+func TestRequireGlobalAuthorization(t *testing.T) {
+	targetID := uuid.New()
+	role := dbmodels.Role{
+		Name: string(roles.RoleAdmin),
+		Authorizations: []dbmodels.Authorization{
+			{Name: string(roles.AuthorizationAuditLogsRead)},
+		},
+	}
+
+	t.Run("No user", func(t *testing.T) {
+		err := authz.RequireGlobalAuthorization(nil, roles.AuthorizationAuditLogsRead)
+		assert.ErrorIs(t, err, authz.ErrNotAuthorized)
+	})
+
+	t.Run("Global role binding", func(t *testing.T) {
+		user := &dbmodels.User{RoleBindings: []dbmodels.UserRole{{Role: role}}}
+		assert.NoError(t, authz.RequireGlobalAuthorization(user, roles.AuthorizationAuditLogsRead))
+		assert.ErrorIs(t, authz.RequireGlobalAuthorization(user, roles.AuthorizationTeamsCreate), authz.ErrNotAuthorized)
+	})
+
+	t.Run("Targeted role binding", func(t *testing.T) {
+		user := &dbmodels.User{RoleBindings: []dbmodels.UserRole{{Role: role, TargetID: &targetID}}}
+		assert.ErrorIs(t, authz.RequireGlobalAuthorization(user, roles.AuthorizationAuditLogsRead), authz.ErrNotAuthorized)
+	})
+}
+
+func TestRequireTeamAuthorization(t *testing.T) {
+	teamID := uuid.New()
+	otherTeamID := uuid.New()
+	role := dbmodels.Role{
+		Name: string(roles.RoleTeamOwner),
+		Authorizations: []dbmodels.Authorization{
+			{Name: string(roles.AuthorizationTeamsUpdate)},
+		},
+	}
+
+	t.Run("No user", func(t *testing.T) {
+		err := authz.RequireTeamAuthorization(nil, roles.AuthorizationTeamsUpdate, teamID)
+		assert.ErrorIs(t, err, authz.ErrNotAuthorized)
+	})
+
+	t.Run("Global role binding", func(t *testing.T) {
+		user := &dbmodels.User{RoleBindings: []dbmodels.UserRole{{Role: role}}}
+		assert.NoError(t, authz.RequireTeamAuthorization(user, roles.AuthorizationTeamsUpdate, teamID))
+		assert.ErrorIs(t, authz.RequireTeamAuthorization(user, roles.AuthorizationTeamsDelete, teamID), authz.ErrNotAuthorized)
+	})
+
+	t.Run("Role binding targeting the team", func(t *testing.T) {
+		user := &dbmodels.User{RoleBindings: []dbmodels.UserRole{{Role: role, TargetID: &teamID}}}
+		assert.NoError(t, authz.RequireTeamAuthorization(user, roles.AuthorizationTeamsUpdate, teamID))
+		assert.ErrorIs(t, authz.RequireTeamAuthorization(user, roles.AuthorizationTeamsUpdate, otherTeamID), authz.ErrNotAuthorized)
+	})
+}
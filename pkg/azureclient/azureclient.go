@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	helpers "github.com/nais/console/pkg/console"
@@ -12,6 +13,9 @@ import (
 	"net/http"
 )
 
+// ErrGroupNotFound Returned when the requested Azure AD group does not exist
+var ErrGroupNotFound = errors.New("group not found")
+
 type client struct {
 	client *http.Client
 }
@@ -78,8 +82,13 @@ func (s *client) GetGroupById(ctx context.Context, id uuid.UUID) (*Group, error)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("azure group with ID '%s' does not exist: %w", id.String(), ErrGroupNotFound)
+	}
+
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("azure group with ID '%s' does not exist", id.String())
+		text, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unable to fetch azure group with ID '%s': %s: %s", id.String(), resp.Status, string(text))
 	}
 
 	dec := json.NewDecoder(resp.Body)
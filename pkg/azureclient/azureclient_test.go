@@ -95,6 +95,7 @@ func Test_GetGroupThatDoesNotExist(t *testing.T) {
 
 	assert.Nil(t, group)
 	assert.ErrorContains(t, err, "azure group with ID")
+	assert.ErrorIs(t, err, ErrGroupNotFound)
 }
 
 func Test_CreateGroup(t *testing.T) {
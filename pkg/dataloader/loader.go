@@ -0,0 +1,121 @@
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// batchWait Time to wait for more keys before fetching a batch. gqlgen resolves the fields of each entry in a
+	// collection concurrently, so all keys for a collection normally arrive well within this window.
+	batchWait = 2 * time.Millisecond
+
+	// maxBatchSize Fetch a batch right away when it reaches this many keys
+	maxBatchSize = 250
+)
+
+// BatchFunc Fetch the values for a set of keys. Keys missing from the returned map get the zero value.
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// Loader Collect keys requested within a short time window, and fetch them with a single call to the batch function.
+// Values are cached for the lifetime of the loader, so a loader must only be used for a single request.
+//
+// Batches are fetched with the context of the loader, typically the context of the request, and not with the context of
+// the caller that happened to request the first key. A canceled caller would otherwise fail the batch for all callers
+// sharing it.
+type Loader[K comparable, V any] struct {
+	ctx   context.Context
+	fetch BatchFunc[K, V]
+	lock  sync.Mutex
+	cache map[K]*result[V]
+	batch *batch[K, V]
+}
+
+type result[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+type batch[K comparable, V any] struct {
+	keys       []K
+	results    []*result[V]
+	dispatched bool
+}
+
+func NewLoader[K comparable, V any](ctx context.Context, fetch BatchFunc[K, V]) *Loader[K, V] {
+	return &Loader[K, V]{
+		ctx:   ctx,
+		fetch: fetch,
+		cache: make(map[K]*result[V]),
+	}
+}
+
+// Load Get the value for a key, waiting for the batch containing the key to be fetched
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.lock.Lock()
+
+	res, cached := l.cache[key]
+	if !cached {
+		res = &result[V]{done: make(chan struct{})}
+		l.cache[key] = res
+		l.enqueue(key, res)
+	}
+
+	l.lock.Unlock()
+
+	select {
+	case <-res.done:
+		return res.value, res.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// enqueue Add a key to the current batch, starting a new batch if needed. Must be called with the lock held.
+func (l *Loader[K, V]) enqueue(key K, res *result[V]) {
+	if l.batch == nil {
+		b := &batch[K, V]{}
+		l.batch = b
+		time.AfterFunc(batchWait, func() {
+			l.dispatch(b)
+		})
+	}
+
+	b := l.batch
+	b.keys = append(b.keys, key)
+	b.results = append(b.results, res)
+
+	if len(b.keys) >= maxBatchSize {
+		l.batch = nil
+		b.dispatched = true
+		go l.run(b)
+	}
+}
+
+func (l *Loader[K, V]) dispatch(b *batch[K, V]) {
+	l.lock.Lock()
+	if b.dispatched {
+		l.lock.Unlock()
+		return
+	}
+	b.dispatched = true
+	if l.batch == b {
+		l.batch = nil
+	}
+	l.lock.Unlock()
+
+	l.run(b)
+}
+
+func (l *Loader[K, V]) run(b *batch[K, V]) {
+	values, err := l.fetch(l.ctx, b.keys)
+	for i, key := range b.keys {
+		res := b.results[i]
+		res.value = values[key]
+		res.err = err
+		close(res.done)
+	}
+}
@@ -0,0 +1,125 @@
+package dataloader_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/nais/console/pkg/dataloader"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoader(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("concurrent loads are fetched in a single batch", func(t *testing.T) {
+		calls := make([][]int, 0)
+		lock := sync.Mutex{}
+		loader := dataloader.NewLoader(ctx, func(ctx context.Context, keys []int) (map[int]string, error) {
+			lock.Lock()
+			calls = append(calls, keys)
+			lock.Unlock()
+
+			values := make(map[int]string)
+			for _, key := range keys {
+				values[key] = fmt.Sprintf("value %d", key)
+			}
+			return values, nil
+		})
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(key int) {
+				defer wg.Done()
+				value, err := loader.Load(ctx, key%5)
+				assert.NoError(t, err)
+				assert.Equal(t, fmt.Sprintf("value %d", key%5), value)
+			}(i)
+		}
+		wg.Wait()
+
+		assert.Len(t, calls, 1)
+		assert.ElementsMatch(t, []int{0, 1, 2, 3, 4}, calls[0])
+
+		value, err := loader.Load(ctx, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, "value 3", value)
+		assert.Len(t, calls, 1)
+	})
+
+	t.Run("missing keys get the zero value", func(t *testing.T) {
+		loader := dataloader.NewLoader(ctx, func(ctx context.Context, keys []int) (map[int]*string, error) {
+			return map[int]*string{}, nil
+		})
+
+		value, err := loader.Load(ctx, 1)
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("errors are returned for all keys in the batch", func(t *testing.T) {
+		loader := dataloader.NewLoader(ctx, func(ctx context.Context, keys []int) (map[int]string, error) {
+			return nil, fmt.Errorf("database is down")
+		})
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func(key int) {
+				defer wg.Done()
+				_, err := loader.Load(ctx, key)
+				assert.EqualError(t, err, "database is down")
+			}(i)
+		}
+		wg.Wait()
+	})
+
+	t.Run("large batches are split", func(t *testing.T) {
+		calls := 0
+		lock := sync.Mutex{}
+		loader := dataloader.NewLoader(ctx, func(ctx context.Context, keys []int) (map[int]int, error) {
+			lock.Lock()
+			calls++
+			lock.Unlock()
+
+			values := make(map[int]int)
+			for _, key := range keys {
+				values[key] = key
+			}
+			return values, nil
+		})
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 600; i++ {
+			wg.Add(1)
+			go func(key int) {
+				defer wg.Done()
+				value, err := loader.Load(ctx, key)
+				assert.NoError(t, err)
+				assert.Equal(t, key, value)
+			}(i)
+		}
+		wg.Wait()
+
+		assert.GreaterOrEqual(t, calls, 3)
+	})
+	t.Run("canceled caller does not fail the batch", func(t *testing.T) {
+		loader := dataloader.NewLoader(ctx, func(ctx context.Context, keys []int) (map[int]int, error) {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return map[int]int{1: 1}, nil
+		})
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := loader.Load(canceled, 1)
+		assert.ErrorIs(t, err, context.Canceled)
+
+		value, err := loader.Load(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, value)
+	})
+}
@@ -0,0 +1,178 @@
+package dataloader
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/dbmodels"
+	"gorm.io/gorm"
+)
+
+type contextKey string
+
+const loadersContextKey contextKey = "loaders"
+
+// Loaders Batching loaders for objects commonly resolved for each entry in a collection
+type Loaders struct {
+	Users        *Loader[uuid.UUID, *dbmodels.User]
+	Teams        *Loader[uuid.UUID, *dbmodels.Team]
+	Systems      *Loader[uuid.UUID, *dbmodels.System]
+	Correlations *Loader[uuid.UUID, *dbmodels.Correlation]
+	TeamUsers    *Loader[uuid.UUID, []*dbmodels.User]
+	UserTeams    *Loader[uuid.UUID, []*dbmodels.Team]
+	HasAPIKey    *Loader[uuid.UUID, bool]
+
+	// ExternalIdentities Keyed by lowercase email address
+	ExternalIdentities *Loader[string, []*dbmodels.ExternalIdentity]
+}
+
+// NewLoaders Create a set of loaders. ctx is used for all database queries made by the loaders, and should be the
+// context of the request the loaders are used for.
+func NewLoaders(ctx context.Context, db *gorm.DB) *Loaders {
+	return &Loaders{
+		Users:        NewLoader(ctx, byID[dbmodels.User](db)),
+		Teams:        NewLoader(ctx, byID[dbmodels.Team](db)),
+		Systems:      NewLoader(ctx, byID[dbmodels.System](db)),
+		Correlations: NewLoader(ctx, byID[dbmodels.Correlation](db)),
+		TeamUsers:    NewLoader(ctx, teamUsers(db)),
+		UserTeams:    NewLoader(ctx, userTeams(db)),
+		HasAPIKey:    NewLoader(ctx, hasAPIKey(db)),
+
+		ExternalIdentities: NewLoader(ctx, externalIdentities(db)),
+	}
+}
+
+// ContextWithLoaders Attach loaders to a context, typically for the duration of a single request
+func ContextWithLoaders(ctx context.Context, loaders *Loaders) context.Context {
+	return context.WithValue(ctx, loadersContextKey, loaders)
+}
+
+// FromContext Get the loaders attached to the context, if any
+func FromContext(ctx context.Context) *Loaders {
+	loaders, _ := ctx.Value(loadersContextKey).(*Loaders)
+	return loaders
+}
+
+// model Constraint for database models identified by the ID of the embedded base model
+type model[T any] interface {
+	*T
+	GetModel() *dbmodels.Model
+}
+
+// byID Fetch objects by their primary key
+func byID[T any, PT model[T]](db *gorm.DB) BatchFunc[uuid.UUID, PT] {
+	return func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]PT, error) {
+		rows := make([]PT, 0, len(ids))
+		err := db.WithContext(ctx).Where("id IN (?)", ids).Find(&rows).Error
+		if err != nil {
+			return nil, err
+		}
+
+		objects := make(map[uuid.UUID]PT, len(rows))
+		for _, row := range rows {
+			objects[*row.GetModel().ID] = row
+		}
+		return objects, nil
+	}
+}
+
+// teamUsers Fetch the members of teams
+func teamUsers(db *gorm.DB) BatchFunc[uuid.UUID, []*dbmodels.User] {
+	return func(ctx context.Context, teamIDs []uuid.UUID) (map[uuid.UUID][]*dbmodels.User, error) {
+		memberships := make([]*dbmodels.UserTeam, 0)
+		err := db.WithContext(ctx).Where("team_id IN (?)", teamIDs).Find(&memberships).Error
+		if err != nil {
+			return nil, err
+		}
+
+		userIDs := make([]uuid.UUID, 0, len(memberships))
+		for _, membership := range memberships {
+			userIDs = append(userIDs, membership.UserID)
+		}
+
+		users, err := byID[dbmodels.User](db)(ctx, userIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make(map[uuid.UUID][]*dbmodels.User, len(teamIDs))
+		for _, id := range teamIDs {
+			result[id] = make([]*dbmodels.User, 0)
+		}
+		for _, membership := range memberships {
+			if user, exists := users[membership.UserID]; exists {
+				result[membership.TeamID] = append(result[membership.TeamID], user)
+			}
+		}
+		return result, nil
+	}
+}
+
+// userTeams Fetch the teams users are members of
+func userTeams(db *gorm.DB) BatchFunc[uuid.UUID, []*dbmodels.Team] {
+	return func(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID][]*dbmodels.Team, error) {
+		memberships := make([]*dbmodels.UserTeam, 0)
+		err := db.WithContext(ctx).Where("user_id IN (?)", userIDs).Find(&memberships).Error
+		if err != nil {
+			return nil, err
+		}
+
+		teamIDs := make([]uuid.UUID, 0, len(memberships))
+		for _, membership := range memberships {
+			teamIDs = append(teamIDs, membership.TeamID)
+		}
+
+		teams, err := byID[dbmodels.Team](db)(ctx, teamIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make(map[uuid.UUID][]*dbmodels.Team, len(userIDs))
+		for _, id := range userIDs {
+			result[id] = make([]*dbmodels.Team, 0)
+		}
+		for _, membership := range memberships {
+			if team, exists := teams[membership.TeamID]; exists {
+				result[membership.UserID] = append(result[membership.UserID], team)
+			}
+		}
+		return result, nil
+	}
+}
+
+// hasAPIKey Check which users have an API key
+func hasAPIKey(db *gorm.DB) BatchFunc[uuid.UUID, bool] {
+	return func(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+		keyOwners := make([]uuid.UUID, 0)
+		err := db.WithContext(ctx).Model(&dbmodels.ApiKey{}).Where("user_id IN (?)", userIDs).Pluck("user_id", &keyOwners).Error
+		if err != nil {
+			return nil, err
+		}
+
+		result := make(map[uuid.UUID]bool, len(keyOwners))
+		for _, id := range keyOwners {
+			result[id] = true
+		}
+		return result, nil
+	}
+}
+
+// externalIdentities Fetch the cached external identities of users, along with their system
+func externalIdentities(db *gorm.DB) BatchFunc[string, []*dbmodels.ExternalIdentity] {
+	return func(ctx context.Context, emails []string) (map[string][]*dbmodels.ExternalIdentity, error) {
+		identities := make([]*dbmodels.ExternalIdentity, 0)
+		err := db.WithContext(ctx).Preload("System").Where("email IN (?)", emails).Order("created_at ASC").Find(&identities).Error
+		if err != nil {
+			return nil, err
+		}
+
+		result := make(map[string][]*dbmodels.ExternalIdentity, len(emails))
+		for _, email := range emails {
+			result[email] = make([]*dbmodels.ExternalIdentity, 0)
+		}
+		for _, identity := range identities {
+			result[identity.Email] = append(result[identity.Email], identity)
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,37 @@
+package dbmodels
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CreateAPIKey Generate a new API key for a user. Any existing API keys for the user will be removed.
+func CreateAPIKey(db *gorm.DB, userID uuid.UUID) (*ApiKey, error) {
+	buf := make([]byte, 16)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &ApiKey{
+		APIKey: base64.RawURLEncoding.EncodeToString(buf),
+		UserID: userID,
+	}
+	err = db.Transaction(func(tx *gorm.DB) error {
+		// FIXME: Handle deleted_by_id tracking
+		err = tx.Where("user_id = ?", key.UserID).Delete(&ApiKey{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(key).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
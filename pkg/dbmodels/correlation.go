@@ -0,0 +1,62 @@
+package dbmodels
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// CorrelationTrigger What caused the changes sharing a correlation
+type CorrelationTrigger string
+
+const (
+	CorrelationTriggerUnknown   CorrelationTrigger = ""          // Correlations created before triggers were recorded
+	CorrelationTriggerMutation  CorrelationTrigger = "mutation"  // A GraphQL mutation
+	CorrelationTriggerCommand   CorrelationTrigger = "command"   // A command line invocation
+	CorrelationTriggerStartup   CorrelationTrigger = "startup"   // The reconcile of all teams when a console replica becomes the leader
+	CorrelationTriggerUserSync  CorrelationTrigger = "user_sync" // The periodic user synchronization
+	CorrelationTriggerRetention CorrelationTrigger = "retention" // The periodic retention job
+	CorrelationTriggerDrift     CorrelationTrigger = "drift"     // Correction of drift found by the drift scan
+	CorrelationTriggerResync    CorrelationTrigger = "resync"    // The periodic full resync of all teams
+)
+
+var correlationTriggers = []CorrelationTrigger{
+	CorrelationTriggerUnknown,
+	CorrelationTriggerMutation,
+	CorrelationTriggerCommand,
+	CorrelationTriggerStartup,
+	CorrelationTriggerUserSync,
+	CorrelationTriggerRetention,
+	CorrelationTriggerDrift,
+	CorrelationTriggerResync,
+}
+
+// MarshalGQL Write the trigger as a GraphQL enum value
+func (t CorrelationTrigger) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(t.enumValue()))
+}
+
+// UnmarshalGQL Parse the trigger from a GraphQL enum value
+func (t *CorrelationTrigger) UnmarshalGQL(v interface{}) error {
+	value, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("correlation trigger must be a string")
+	}
+
+	for _, trigger := range correlationTriggers {
+		if trigger.enumValue() == value {
+			*t = trigger
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is not a valid CorrelationTrigger", value)
+}
+
+func (t CorrelationTrigger) enumValue() string {
+	if t == CorrelationTriggerUnknown {
+		return "UNKNOWN"
+	}
+	return strings.ToUpper(string(t))
+}
@@ -0,0 +1,47 @@
+package dbmodels
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DriftKind The kind of difference between an external system and the desired state of a team
+type DriftKind string
+
+const (
+	DriftKindExtraMember     DriftKind = "extra_member"     // A member of the external resource is not a member of the team
+	DriftKindMissingMember   DriftKind = "missing_member"   // A member of the team is not a member of the external resource
+	DriftKindRenamed         DriftKind = "renamed"          // The external resource has been renamed
+	DriftKindMissingResource DriftKind = "missing_resource" // The external resource does not exist
+)
+
+var driftKinds = []DriftKind{
+	DriftKindExtraMember,
+	DriftKindMissingMember,
+	DriftKindRenamed,
+	DriftKindMissingResource,
+}
+
+// MarshalGQL Write the kind as a GraphQL enum value
+func (k DriftKind) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(strings.ToUpper(string(k))))
+}
+
+// UnmarshalGQL Parse the kind from a GraphQL enum value
+func (k *DriftKind) UnmarshalGQL(v interface{}) error {
+	value, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("drift kind must be a string")
+	}
+
+	for _, kind := range driftKinds {
+		if strings.ToUpper(string(kind)) == value {
+			*k = kind
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is not a valid DriftKind", value)
+}
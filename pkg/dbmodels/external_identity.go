@@ -0,0 +1,73 @@
+package dbmodels
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReplaceExternalIdentities Replace the cached identities of a system with identities, a map of email addresses to
+// usernames. Identities that are still present keep their creation time.
+func ReplaceExternalIdentities(db *gorm.DB, systemId uuid.UUID, identities map[string]string) error {
+	// Truncated to the precision of the database, so rows written now are not mistaken for stale ones
+	now := time.Now().Truncate(time.Microsecond)
+
+	rows := make([]*ExternalIdentity, 0, len(identities))
+	for email, username := range identities {
+		rows = append(rows, &ExternalIdentity{
+			Model: Model{
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			SystemID: systemId,
+			Email:    strings.ToLower(email),
+			Username: username,
+		})
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if tx.Dialector.Name() == "postgres" {
+			err := tx.Exec("SELECT pg_advisory_xact_lock(?)", ExternalIdentitiesLockID).Error
+			if err != nil {
+				return fmt.Errorf("acquire external identities lock: %w", err)
+			}
+		}
+
+		if len(rows) > 0 {
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "system_id"}, {Name: "email"}},
+				DoUpdates: clause.AssignmentColumns([]string{"username", "updated_at"}),
+			}).CreateInBatches(rows, 1000).Error
+			if err != nil {
+				return fmt.Errorf("store external identities: %w", err)
+			}
+		}
+
+		err := tx.Where("system_id = ? AND updated_at < ?", systemId, now).Delete(&ExternalIdentity{}).Error
+		if err != nil {
+			return fmt.Errorf("remove stale external identities: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ExternalIdentitiesUpdatedAt Get the time the cached identities of a system were last fetched, or nil if the system
+// has no cached identities
+func ExternalIdentitiesUpdatedAt(db *gorm.DB, systemId uuid.UUID) (*time.Time, error) {
+	identity := &ExternalIdentity{}
+	err := db.Where("system_id = ?", systemId).Order("updated_at DESC").First(identity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get external identities: %w", err)
+	}
+
+	return &identity.UpdatedAt, nil
+}
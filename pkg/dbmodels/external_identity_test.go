@@ -0,0 +1,67 @@
+package dbmodels
+
+import (
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestReplaceExternalIdentities(t *testing.T) {
+	db := test.GetTestDB()
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	db.AutoMigrate(System{}, ExternalIdentity{})
+
+	github := &System{Name: "github:team"}
+	other := &System{Name: "other"}
+	db.Create(github)
+	db.Create(other)
+
+	identities := func() map[string]*ExternalIdentity {
+		rows := make([]*ExternalIdentity, 0)
+		db.Where("system_id = ?", github.ID).Find(&rows)
+		result := make(map[string]*ExternalIdentity)
+		for _, row := range rows {
+			result[row.Email] = row
+		}
+		return result
+	}
+
+	updatedAt, err := ExternalIdentitiesUpdatedAt(db, *github.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, updatedAt)
+
+	db.Create(&ExternalIdentity{SystemID: *other.ID, Email: "kept@example.com", Username: "kept"})
+
+	err = ReplaceExternalIdentities(db, *github.ID, map[string]string{
+		"User@Example.com":    "user",
+		"removed@example.com": "removed",
+	})
+	assert.NoError(t, err)
+
+	stored := identities()
+	assert.Len(t, stored, 2)
+	assert.Equal(t, "user", stored["user@example.com"].Username)
+	created := stored["user@example.com"].CreatedAt
+
+	updatedAt, err = ExternalIdentitiesUpdatedAt(db, *github.ID)
+	assert.NoError(t, err)
+	assert.NotNil(t, updatedAt)
+
+	time.Sleep(10 * time.Millisecond)
+	err = ReplaceExternalIdentities(db, *github.ID, map[string]string{
+		"user@example.com": "renamed-user",
+	})
+	assert.NoError(t, err)
+
+	stored = identities()
+	assert.Len(t, stored, 1)
+	assert.Equal(t, "renamed-user", stored["user@example.com"].Username)
+	assert.True(t, stored["user@example.com"].CreatedAt.Equal(created))
+	assert.True(t, stored["user@example.com"].UpdatedAt.After(*updatedAt))
+
+	var count int64
+	db.Model(&ExternalIdentity{}).Where("system_id = ?", other.ID).Count(&count)
+	assert.Equal(t, int64(1), count)
+}
@@ -0,0 +1,22 @@
+package dbmodels
+
+// Keys for PostgreSQL advisory locks. The values are arbitrary, but every lock needs a key of its own, as a lock held on
+// a key blocks everyone else waiting for the same key.
+const (
+	// MigrationLockID Held while migrating, so concurrently starting replicas don't race each other
+	MigrationLockID = 4611686018427387904
+
+	// AuditLogChainLockID Held while appending to the audit log hash chain, so entries created by different replicas
+	// are not given the same sequence number
+	AuditLogChainLockID = 4611686018427387905
+
+	// FixturesLockID Held while inserting fixtures, so concurrently starting replicas don't insert the same rows twice
+	FixturesLockID = 4611686018427387906
+
+	// LeaderLockID Held by the leader for as long as it is the leader
+	LeaderLockID = 4611686018427387907
+
+	// ExternalIdentitiesLockID Held while replacing the cached identities of a system, so concurrent refreshes don't
+	// overwrite each other
+	ExternalIdentitiesLockID = 4611686018427387908
+)
@@ -1,24 +1,266 @@
 package dbmodels
 
 import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// Migration A single versioned schema migration. Migrations are read from files named
+// <version>_<name>.up.sql and <version>_<name>.down.sql.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// SchemaMigration A row in the schema version table, one for each applied migration
+type SchemaMigration struct {
+	Version   int       `gorm:"primaryKey; autoIncrement:false"`
+	Name      string    `gorm:"not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+// MigrationStatus Status of a single known migration
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	AppliedAt *time.Time
+}
+
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// Migrate Apply all pending migrations bundled with Console
 func Migrate(db *gorm.DB) error {
-	return db.AutoMigrate(
-		&ApiKey{},
-		&AuditLog{},
-		&Authorization{},
-		&Correlation{},
-		&ReconcileError{},
-		&Role{},
-		&RoleAuthorization{},
-		&SystemState{},
-		&System{},
-		&TeamMetadata{},
-		&Team{},
-		&User{},
-		&UserRole{},
-		&UserTeam{},
-	)
+	migrator, err := NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	return migrator.Up()
+}
+
+// NewMigrator Create a migrator for the migrations bundled with Console
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	migrations, err := LoadMigrations(migrationFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMigratorWithMigrations(db, migrations), nil
+}
+
+// NewMigratorWithMigrations Create a migrator for a custom set of migrations
+func NewMigratorWithMigrations(db *gorm.DB, migrations []Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}
+}
+
+// LoadMigrations Read all migration files from a filesystem, ordered by version
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	files, err := fs.Glob(fsys, "*/*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, file := range files {
+		base := path.Base(file)
+		var direction string
+		switch {
+		case strings.HasSuffix(base, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(base, ".down.sql"):
+			direction = "down"
+		default:
+			return nil, fmt.Errorf("migration file '%s' must end with .up.sql or .down.sql", base)
+		}
+
+		parts := strings.SplitN(strings.TrimSuffix(base, "."+direction+".sql"), "_", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("migration file '%s' must be named <version>_<name>.%s.sql", base, direction)
+		}
+
+		version, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("migration file '%s' has an invalid version: %w", base, err)
+		}
+
+		contents, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, exists := byVersion[version]
+		if !exists {
+			migration = &Migration{Version: version, Name: parts[1]}
+			byVersion[version] = migration
+		}
+
+		if migration.Name != parts[1] {
+			return nil, fmt.Errorf("migration version %d is used by both '%s' and '%s'", version, migration.Name, parts[1])
+		}
+
+		if direction == "up" {
+			migration.Up = string(contents)
+		} else {
+			migration.Down = string(contents)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s is missing an up migration", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Up Apply all pending migrations in order
+func (m *Migrator) Up() error {
+	return m.locked(func(tx *gorm.DB, applied map[int]SchemaMigration) error {
+		for _, migration := range m.migrations {
+			if _, exists := applied[migration.Version]; exists {
+				continue
+			}
+
+			log.Infof("Applying migration %d_%s...", migration.Version, migration.Name)
+			err := tx.Exec(migration.Up).Error
+			if err != nil {
+				return fmt.Errorf("apply migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			err = tx.Create(&SchemaMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now(),
+			}).Error
+			if err != nil {
+				return fmt.Errorf("record migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// Down Roll back the given number of applied migrations, starting with the most recent one
+func (m *Migrator) Down(steps int) error {
+	return m.locked(func(tx *gorm.DB, applied map[int]SchemaMigration) error {
+		for i := len(m.migrations) - 1; i >= 0 && steps > 0; i-- {
+			migration := m.migrations[i]
+			if _, exists := applied[migration.Version]; !exists {
+				continue
+			}
+
+			if migration.Down == "" {
+				return fmt.Errorf("migration %d_%s can not be rolled back", migration.Version, migration.Name)
+			}
+
+			log.Infof("Rolling back migration %d_%s...", migration.Version, migration.Name)
+			err := tx.Exec(migration.Down).Error
+			if err != nil {
+				return fmt.Errorf("roll back migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			err = tx.Delete(&SchemaMigration{}, migration.Version).Error
+			if err != nil {
+				return fmt.Errorf("remove migration record %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			steps--
+		}
+
+		return nil
+	})
+}
+
+// Status List all known migrations along with the time they were applied, if at all
+func (m *Migrator) Status() ([]MigrationStatus, error) {
+	status := make([]MigrationStatus, 0, len(m.migrations))
+	err := m.locked(func(_ *gorm.DB, applied map[int]SchemaMigration) error {
+		for _, migration := range m.migrations {
+			entry := MigrationStatus{
+				Version: migration.Version,
+				Name:    migration.Name,
+			}
+			if schemaMigration, exists := applied[migration.Version]; exists {
+				entry.AppliedAt = &schemaMigration.AppliedAt
+			}
+			status = append(status, entry)
+		}
+		return nil
+	})
+
+	return status, err
+}
+
+// Version Get the version of the most recently applied migration, or 0 if no migrations have been applied
+func (m *Migrator) Version() (int, error) {
+	var version int
+	err := m.db.Model(&SchemaMigration{}).Select("COALESCE(MAX(version), 0)").Scan(&version).Error
+	return version, err
+}
+
+// LatestVersion Get the version of the most recent known migration
+func (m *Migrator) LatestVersion() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// locked Run fn in a transaction while holding the migration lock. The applied migrations are read after the lock has
+// been acquired, so they reflect any changes made by other processes holding the lock before us.
+func (m *Migrator) locked(fn func(tx *gorm.DB, applied map[int]SchemaMigration) error) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		if tx.Dialector.Name() == "postgres" {
+			err := tx.Exec("SELECT pg_advisory_xact_lock(?)", MigrationLockID).Error
+			if err != nil {
+				return fmt.Errorf("acquire migration lock: %w", err)
+			}
+		}
+
+		err := tx.Migrator().AutoMigrate(&SchemaMigration{})
+		if err != nil {
+			return fmt.Errorf("create schema version table: %w", err)
+		}
+
+		rows := make([]SchemaMigration, 0)
+		err = tx.Find(&rows).Error
+		if err != nil {
+			return fmt.Errorf("list applied migrations: %w", err)
+		}
+
+		applied := make(map[int]SchemaMigration, len(rows))
+		for _, row := range rows {
+			applied[row.Version] = row
+		}
+
+		return fn(tx, applied)
+	})
 }
@@ -0,0 +1,216 @@
+package dbmodels
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/jackc/pgtype"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// migratedModels All models stored in tables created by the bundled migrations
+var migratedModels = []interface{}{
+	&ApiKey{},
+	&AuditLog{},
+	&Authorization{},
+	&Correlation{},
+	&DriftFinding{},
+	&ExternalIdentity{},
+	&Notification{},
+	&ReconcileError{},
+	&ReconcileQueueEntry{},
+	&ReconcileResult{},
+	&Role{},
+	&RoleAuthorization{},
+	&SchemaMigration{},
+	&System{},
+	&SystemState{},
+	&Team{},
+	&TeamMetadata{},
+	&TeamRepository{},
+	&User{},
+	&UserRole{},
+	&UserTeam{},
+}
+
+func testMigrations() []Migration {
+	return []Migration{
+		{Version: 1, Name: "first", Up: "CREATE TABLE first (id int);", Down: "DROP TABLE first;"},
+		{Version: 2, Name: "second", Up: "CREATE TABLE second (id int);", Down: "DROP TABLE second;"},
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	t.Run("Bundled migrations", func(t *testing.T) {
+		migrations, err := LoadMigrations(migrationFiles)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, migrations)
+		assert.Equal(t, 1, migrations[0].Version)
+		assert.Equal(t, "baseline", migrations[0].Name)
+		assert.NotEmpty(t, migrations[0].Up)
+		assert.NotEmpty(t, migrations[0].Down)
+
+		for i := 1; i < len(migrations); i++ {
+			assert.Greater(t, migrations[i].Version, migrations[i-1].Version)
+		}
+	})
+
+	t.Run("Ordered by version", func(t *testing.T) {
+		migrations, err := LoadMigrations(fstest.MapFS{
+			"migrations/0010_ten.up.sql":   {Data: []byte("ten")},
+			"migrations/0002_two.up.sql":   {Data: []byte("two")},
+			"migrations/0002_two.down.sql": {Data: []byte("undo two")},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, migrations, 2)
+		assert.Equal(t, Migration{Version: 2, Name: "two", Up: "two", Down: "undo two"}, migrations[0])
+		assert.Equal(t, Migration{Version: 10, Name: "ten", Up: "ten"}, migrations[1])
+	})
+
+	t.Run("Invalid file names", func(t *testing.T) {
+		_, err := LoadMigrations(fstest.MapFS{"migrations/baseline.up.sql": {}})
+		assert.Error(t, err)
+
+		_, err = LoadMigrations(fstest.MapFS{"migrations/0001_baseline.sql": {}})
+		assert.Error(t, err)
+
+		_, err = LoadMigrations(fstest.MapFS{"migrations/0001_baseline.down.sql": {Data: []byte("down")}})
+		assert.ErrorContains(t, err, "missing an up migration")
+	})
+}
+
+func TestMigrator(t *testing.T) {
+	t.Run("Up, status and down", func(t *testing.T) {
+		db := test.GetTestDB()
+		migrator := NewMigratorWithMigrations(db, testMigrations())
+
+		version, err := migrator.Version()
+		assert.Error(t, err, "schema version table does not exist yet")
+
+		assert.NoError(t, migrator.Up())
+		assert.True(t, db.Migrator().HasTable("first"))
+		assert.True(t, db.Migrator().HasTable("second"))
+
+		version, err = migrator.Version()
+		assert.NoError(t, err)
+		assert.Equal(t, 2, version)
+		assert.Equal(t, 2, migrator.LatestVersion())
+
+		// Running the migrations again is a no-op
+		assert.NoError(t, migrator.Up())
+
+		assert.NoError(t, migrator.Down(1))
+		assert.True(t, db.Migrator().HasTable("first"))
+		assert.False(t, db.Migrator().HasTable("second"))
+
+		status, err := migrator.Status()
+		assert.NoError(t, err)
+		assert.Len(t, status, 2)
+		assert.NotNil(t, status[0].AppliedAt)
+		assert.Nil(t, status[1].AppliedAt)
+
+		assert.NoError(t, migrator.Down(5))
+		version, err = migrator.Version()
+		assert.NoError(t, err)
+		assert.Equal(t, 0, version)
+	})
+
+	t.Run("Failing migration is rolled back", func(t *testing.T) {
+		db := test.GetTestDB()
+		migrations := append(testMigrations(), Migration{Version: 3, Name: "broken", Up: "THIS IS NOT SQL"})
+		migrator := NewMigratorWithMigrations(db, migrations)
+
+		assert.ErrorContains(t, migrator.Up(), "apply migration 3_broken")
+		assert.False(t, db.Migrator().HasTable("first"))
+	})
+
+	t.Run("Migration without down can not be rolled back", func(t *testing.T) {
+		db := test.GetTestDB()
+		migrator := NewMigratorWithMigrations(db, []Migration{{Version: 1, Name: "forward-only", Up: "CREATE TABLE first (id int);"}})
+
+		assert.NoError(t, migrator.Up())
+		assert.ErrorContains(t, migrator.Down(1), "can not be rolled back")
+	})
+}
+
+func TestMigrate_WithPostgres(t *testing.T) {
+	db := test.GetPostgresTestDB(t)
+	assert.NoError(t, Migrate(db))
+
+	migrator, err := NewMigrator(db)
+	assert.NoError(t, err)
+
+	version, err := migrator.Version()
+	assert.NoError(t, err)
+	assert.Equal(t, migrator.LatestVersion(), version)
+
+	t.Run("Models match the migrated schema", func(t *testing.T) {
+		for _, model := range migratedModels {
+			stmt := &gorm.Statement{DB: db}
+			assert.NoError(t, stmt.Parse(model))
+			table := stmt.Schema.Table
+
+			columns := make([]string, 0)
+			err := db.Raw("SELECT column_name FROM information_schema.columns WHERE table_schema = CURRENT_SCHEMA() AND table_name = ?", table).Scan(&columns).Error
+			assert.NoError(t, err)
+			assert.NotEmpty(t, columns, "table %s does not exist", table)
+
+			// Columns without a field can not be written by Console, and fields without a column can not be stored
+			assert.ElementsMatch(t, stmt.Schema.DBNames, columns, "columns of table %s do not match the model", table)
+		}
+	})
+
+	t.Run("Audit log entries are immutable", func(t *testing.T) {
+		system := &System{Name: "console"}
+		corr := &Correlation{}
+		assert.NoError(t, db.Create(system).Error)
+		assert.NoError(t, db.Create(corr).Error)
+
+		entry := &AuditLog{
+			CorrelationID:  *corr.ID,
+			TargetSystemID: *system.ID,
+			Action:         "action",
+			Message:        "message",
+			Details:        pgtype.JSONB{Status: pgtype.Null},
+			Sequence:       1,
+			Hash:           "hash",
+		}
+		assert.NoError(t, db.Create(entry).Error)
+
+		// Raw statements are used, as gorm hooks would otherwise reject the changes before they reach the database
+		err := db.Exec("UPDATE audit_logs SET message = 'changed' WHERE id = ?", entry.ID).Error
+		assert.ErrorContains(t, err, "audit log entries can not be modified or deleted")
+
+		err = db.Exec("DELETE FROM audit_logs WHERE id = ?", entry.ID).Error
+		assert.ErrorContains(t, err, "audit log entries can not be modified or deleted")
+
+		// The retention job purges entries with the setting enabled within its transaction
+		err = db.Transaction(func(tx *gorm.DB) error {
+			err := tx.Exec("SET LOCAL console.audit_log_retention = 'on'").Error
+			if err != nil {
+				return err
+			}
+			return tx.Exec("DELETE FROM audit_logs WHERE id = ?", entry.ID).Error
+		})
+		assert.NoError(t, err)
+
+		var count int64
+		db.Model(&AuditLog{}).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("All migrations can be rolled back and applied again", func(t *testing.T) {
+		assert.NoError(t, migrator.Down(migrator.LatestVersion()))
+		version, err := migrator.Version()
+		assert.NoError(t, err)
+		assert.Equal(t, 0, version)
+		assert.False(t, db.Migrator().HasTable(&User{}))
+
+		assert.NoError(t, migrator.Up())
+		version, err = migrator.Version()
+		assert.NoError(t, err)
+		assert.Equal(t, migrator.LatestVersion(), version)
+	})
+}
@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS
+    user_teams,
+    user_roles,
+    team_metadata,
+    system_states,
+    reconcile_errors,
+    role_authorizations,
+    roles,
+    authorizations,
+    audit_logs,
+    api_keys,
+    correlations,
+    teams,
+    systems,
+    users;
@@ -0,0 +1,246 @@
+-- Baseline schema, matching what gorm.AutoMigrate produced for the models before versioned migrations were introduced.
+-- All statements are idempotent so the baseline can be applied to databases created by AutoMigrate.
+
+-- uuid-ossp is needed for PostgreSQL to generate UUIDs as primary keys
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+CREATE TABLE IF NOT EXISTS users (
+    id            uuid DEFAULT uuid_generate_v4(),
+    created_at    timestamptz NOT NULL,
+    created_by_id uuid,
+    updated_by_id uuid,
+    updated_at    timestamptz NOT NULL,
+    deleted_by_id uuid,
+    deleted_at    timestamptz,
+    email         text NOT NULL UNIQUE,
+    name          text NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_users_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_users_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_users_deleted_by FOREIGN KEY (deleted_by_id) REFERENCES users (id)
+);
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_users_created_at ON users (created_at);
+
+CREATE TABLE IF NOT EXISTS systems (
+    id            uuid DEFAULT uuid_generate_v4(),
+    created_at    timestamptz NOT NULL,
+    created_by_id uuid,
+    updated_by_id uuid,
+    updated_at    timestamptz NOT NULL,
+    deleted_by_id uuid,
+    deleted_at    timestamptz,
+    name          text NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_systems_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_systems_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_systems_deleted_by FOREIGN KEY (deleted_by_id) REFERENCES users (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_systems_name ON systems (name);
+CREATE INDEX IF NOT EXISTS idx_systems_deleted_at ON systems (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_systems_created_at ON systems (created_at);
+
+CREATE TABLE IF NOT EXISTS teams (
+    id            uuid DEFAULT uuid_generate_v4(),
+    created_at    timestamptz NOT NULL,
+    created_by_id uuid,
+    updated_by_id uuid,
+    updated_at    timestamptz NOT NULL,
+    deleted_by_id uuid,
+    deleted_at    timestamptz,
+    slug          text NOT NULL UNIQUE,
+    name          text NOT NULL UNIQUE,
+    purpose       text,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_teams_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_teams_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_teams_deleted_by FOREIGN KEY (deleted_by_id) REFERENCES users (id)
+);
+CREATE INDEX IF NOT EXISTS idx_teams_deleted_at ON teams (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_teams_created_at ON teams (created_at);
+
+CREATE TABLE IF NOT EXISTS correlations (
+    id            uuid DEFAULT uuid_generate_v4(),
+    created_at    timestamptz NOT NULL,
+    created_by_id uuid,
+    updated_by_id uuid,
+    updated_at    timestamptz NOT NULL,
+    deleted_by_id uuid,
+    deleted_at    timestamptz,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_correlations_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_correlations_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_correlations_deleted_by FOREIGN KEY (deleted_by_id) REFERENCES users (id)
+);
+CREATE INDEX IF NOT EXISTS idx_correlations_deleted_at ON correlations (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_correlations_created_at ON correlations (created_at);
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    id            uuid DEFAULT uuid_generate_v4(),
+    created_at    timestamptz NOT NULL,
+    created_by_id uuid,
+    updated_by_id uuid,
+    updated_at    timestamptz NOT NULL,
+    deleted_by_id uuid,
+    deleted_at    timestamptz,
+    api_key       text NOT NULL UNIQUE,
+    user_id       uuid NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_api_keys_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_api_keys_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_api_keys_deleted_by FOREIGN KEY (deleted_by_id) REFERENCES users (id),
+    CONSTRAINT fk_api_keys_user FOREIGN KEY (user_id) REFERENCES users (id)
+);
+CREATE INDEX IF NOT EXISTS idx_api_keys_deleted_at ON api_keys (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_api_keys_created_at ON api_keys (created_at);
+
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id               uuid DEFAULT uuid_generate_v4(),
+    created_at       timestamptz NOT NULL,
+    created_by_id    uuid,
+    updated_by_id    uuid,
+    updated_at       timestamptz NOT NULL,
+    deleted_by_id    uuid,
+    deleted_at       timestamptz,
+    actor_id         uuid,
+    correlation_id   uuid NOT NULL,
+    target_system_id uuid NOT NULL,
+    target_team_id   uuid,
+    target_user_id   uuid,
+    action           text NOT NULL,
+    message          text NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_audit_logs_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_audit_logs_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_audit_logs_deleted_by FOREIGN KEY (deleted_by_id) REFERENCES users (id),
+    CONSTRAINT fk_audit_logs_actor FOREIGN KEY (actor_id) REFERENCES users (id),
+    CONSTRAINT fk_audit_logs_correlation FOREIGN KEY (correlation_id) REFERENCES correlations (id),
+    CONSTRAINT fk_audit_logs_target_system FOREIGN KEY (target_system_id) REFERENCES systems (id),
+    CONSTRAINT fk_teams_audit_logs FOREIGN KEY (target_team_id) REFERENCES teams (id),
+    CONSTRAINT fk_audit_logs_target_user FOREIGN KEY (target_user_id) REFERENCES users (id)
+);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_action ON audit_logs (action);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_deleted_at ON audit_logs (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_created_at ON audit_logs (created_at);
+
+CREATE TABLE IF NOT EXISTS authorizations (
+    id            uuid DEFAULT uuid_generate_v4(),
+    created_at    timestamptz NOT NULL,
+    created_by_id uuid,
+    updated_by_id uuid,
+    updated_at    timestamptz NOT NULL,
+    name          text NOT NULL UNIQUE,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_authorizations_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_authorizations_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id)
+);
+CREATE INDEX IF NOT EXISTS idx_authorizations_created_at ON authorizations (created_at);
+
+CREATE TABLE IF NOT EXISTS roles (
+    id            uuid DEFAULT uuid_generate_v4(),
+    created_at    timestamptz NOT NULL,
+    created_by_id uuid,
+    updated_by_id uuid,
+    updated_at    timestamptz NOT NULL,
+    name          text NOT NULL UNIQUE,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_roles_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_roles_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id)
+);
+CREATE INDEX IF NOT EXISTS idx_roles_created_at ON roles (created_at);
+
+CREATE TABLE IF NOT EXISTS role_authorizations (
+    authorization_id uuid,
+    role_id          uuid,
+    PRIMARY KEY (authorization_id, role_id),
+    CONSTRAINT fk_role_authorizations_authorization FOREIGN KEY (authorization_id) REFERENCES authorizations (id),
+    CONSTRAINT fk_role_authorizations_role FOREIGN KEY (role_id) REFERENCES roles (id)
+);
+
+CREATE TABLE IF NOT EXISTS reconcile_errors (
+    id             uuid DEFAULT uuid_generate_v4(),
+    created_at     timestamptz NOT NULL,
+    created_by_id  uuid,
+    updated_by_id  uuid,
+    updated_at     timestamptz NOT NULL,
+    deleted_by_id  uuid,
+    deleted_at     timestamptz,
+    correlation_id uuid NOT NULL,
+    system_id      uuid NOT NULL,
+    team_id        uuid NOT NULL,
+    message        text NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_reconcile_errors_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_reconcile_errors_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_reconcile_errors_deleted_by FOREIGN KEY (deleted_by_id) REFERENCES users (id),
+    CONSTRAINT fk_reconcile_errors_correlation FOREIGN KEY (correlation_id) REFERENCES correlations (id),
+    CONSTRAINT fk_reconcile_errors_system FOREIGN KEY (system_id) REFERENCES systems (id),
+    CONSTRAINT fk_reconcile_errors_team FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS correlation_system_team_key ON reconcile_errors (correlation_id, system_id, team_id);
+CREATE INDEX IF NOT EXISTS idx_reconcile_errors_deleted_at ON reconcile_errors (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_reconcile_errors_created_at ON reconcile_errors (created_at);
+
+CREATE TABLE IF NOT EXISTS system_states (
+    id            uuid DEFAULT uuid_generate_v4(),
+    created_at    timestamptz NOT NULL,
+    created_by_id uuid,
+    updated_by_id uuid,
+    updated_at    timestamptz NOT NULL,
+    system_id     uuid NOT NULL,
+    team_id       uuid NOT NULL,
+    state         jsonb NOT NULL DEFAULT '{}',
+    PRIMARY KEY (id),
+    CONSTRAINT fk_system_states_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_system_states_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_system_states_system FOREIGN KEY (system_id) REFERENCES systems (id),
+    CONSTRAINT fk_system_states_team FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS system_team_key ON system_states (system_id, team_id);
+CREATE INDEX IF NOT EXISTS idx_system_states_system_id ON system_states (system_id);
+CREATE INDEX IF NOT EXISTS idx_system_states_team_id ON system_states (team_id);
+CREATE INDEX IF NOT EXISTS idx_system_states_created_at ON system_states (created_at);
+
+CREATE TABLE IF NOT EXISTS team_metadata (
+    id            uuid DEFAULT uuid_generate_v4(),
+    created_at    timestamptz NOT NULL,
+    created_by_id uuid,
+    updated_by_id uuid,
+    updated_at    timestamptz NOT NULL,
+    team_id       uuid NOT NULL,
+    key           text NOT NULL,
+    value         text,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_team_metadata_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_team_metadata_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_teams_metadata FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS team_key ON team_metadata (team_id, key);
+CREATE INDEX IF NOT EXISTS idx_team_metadata_created_at ON team_metadata (created_at);
+
+CREATE TABLE IF NOT EXISTS user_roles (
+    role_id   uuid,
+    user_id   uuid,
+    target_id uuid,
+    PRIMARY KEY (role_id, user_id),
+    CONSTRAINT fk_user_roles_role FOREIGN KEY (role_id) REFERENCES roles (id),
+    CONSTRAINT fk_users_role_bindings FOREIGN KEY (user_id) REFERENCES users (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS user_role_target ON user_roles (role_id, user_id, target_id);
+
+CREATE TABLE IF NOT EXISTS user_teams (
+    id            uuid DEFAULT uuid_generate_v4(),
+    created_at    timestamptz NOT NULL,
+    created_by_id uuid,
+    updated_by_id uuid,
+    updated_at    timestamptz NOT NULL,
+    user_id       uuid NOT NULL,
+    team_id       uuid NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_user_teams_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_user_teams_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_user_teams_user FOREIGN KEY (user_id) REFERENCES users (id),
+    CONSTRAINT fk_user_teams_team FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS user_teams_index ON user_teams (user_id, team_id);
+CREATE INDEX IF NOT EXISTS idx_user_teams_created_at ON user_teams (created_at);
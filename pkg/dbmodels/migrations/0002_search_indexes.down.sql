@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_audit_logs_action_pattern;
+DROP INDEX IF EXISTS idx_users_email_trgm;
+DROP INDEX IF EXISTS idx_users_name_trgm;
+DROP INDEX IF EXISTS idx_teams_purpose_trgm;
+DROP INDEX IF EXISTS idx_teams_name_trgm;
+DROP INDEX IF EXISTS idx_teams_slug_trgm;
@@ -0,0 +1,11 @@
+-- Trigram indexes for case-insensitive substring search, i.e. LOWER(column) LIKE '%term%'
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_teams_slug_trgm ON teams USING gin (LOWER(slug) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_teams_name_trgm ON teams USING gin (LOWER(name) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_teams_purpose_trgm ON teams USING gin (LOWER(purpose) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_name_trgm ON users USING gin (LOWER(name) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_email_trgm ON users USING gin (LOWER(email) gin_trgm_ops);
+
+-- Prefix matches on audit log actions, i.e. action LIKE 'github:team:%'
+CREATE INDEX IF NOT EXISTS idx_audit_logs_action_pattern ON audit_logs (action text_pattern_ops);
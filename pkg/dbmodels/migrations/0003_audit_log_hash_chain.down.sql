@@ -0,0 +1,14 @@
+DROP TRIGGER IF EXISTS audit_logs_immutable ON audit_logs;
+DROP FUNCTION IF EXISTS audit_logs_immutable();
+
+ALTER TABLE audit_logs
+    DROP CONSTRAINT IF EXISTS audit_logs_sequence_key,
+    DROP COLUMN IF EXISTS sequence,
+    DROP COLUMN IF EXISTS previous_hash,
+    DROP COLUMN IF EXISTS hash;
+
+ALTER TABLE audit_logs
+    ADD COLUMN deleted_by_id uuid,
+    ADD COLUMN deleted_at    timestamptz,
+    ADD CONSTRAINT fk_audit_logs_deleted_by FOREIGN KEY (deleted_by_id) REFERENCES users (id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_deleted_at ON audit_logs (deleted_at);
@@ -0,0 +1,41 @@
+-- Audit log entries can no longer be soft deleted. Entries that have been soft deleted become visible again.
+DROP INDEX IF EXISTS idx_audit_logs_deleted_at;
+ALTER TABLE audit_logs
+    DROP CONSTRAINT IF EXISTS fk_audit_logs_deleted_by,
+    DROP COLUMN IF EXISTS deleted_by_id,
+    DROP COLUMN IF EXISTS deleted_at;
+
+-- Existing entries are numbered in creation order. Their hashes are computed by console on startup, as the hash
+-- function lives in the application.
+ALTER TABLE audit_logs
+    ADD COLUMN sequence      bigint,
+    ADD COLUMN previous_hash text NOT NULL DEFAULT '',
+    ADD COLUMN hash          text NOT NULL DEFAULT '';
+
+UPDATE audit_logs
+SET sequence = numbered.sequence
+FROM (SELECT id, ROW_NUMBER() OVER (ORDER BY created_at, id) AS sequence FROM audit_logs) AS numbered
+WHERE audit_logs.id = numbered.id;
+
+ALTER TABLE audit_logs
+    ALTER COLUMN sequence SET NOT NULL,
+    ALTER COLUMN previous_hash DROP DEFAULT,
+    ALTER COLUMN hash DROP DEFAULT,
+    ADD CONSTRAINT audit_logs_sequence_key UNIQUE (sequence);
+
+-- Reject deletes, and updates of entries that have been added to the hash chain, regardless of the client
+CREATE OR REPLACE FUNCTION audit_logs_immutable() RETURNS trigger AS
+$$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.hash = '' THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'audit log entries can not be modified or deleted';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_logs_immutable
+    BEFORE UPDATE OR DELETE
+    ON audit_logs
+    FOR EACH ROW
+EXECUTE FUNCTION audit_logs_immutable();
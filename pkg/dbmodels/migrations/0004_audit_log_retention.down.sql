@@ -0,0 +1,9 @@
+CREATE OR REPLACE FUNCTION audit_logs_immutable() RETURNS trigger AS
+$$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.hash = '' THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'audit log entries can not be modified or deleted';
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,14 @@
+-- Allow the retention job to purge archived audit log entries. The setting is only enabled with SET LOCAL, within the
+-- transaction that purges entries.
+CREATE OR REPLACE FUNCTION audit_logs_immutable() RETURNS trigger AS
+$$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.hash = '' THEN
+        RETURN NEW;
+    END IF;
+    IF TG_OP = 'DELETE' AND current_setting('console.audit_log_retention', true) = 'on' THEN
+        RETURN OLD;
+    END IF;
+    RAISE EXCEPTION 'audit log entries can not be modified or deleted';
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS reconcile_results;
+
+ALTER TABLE correlations DROP COLUMN IF EXISTS operation;
+ALTER TABLE correlations DROP COLUMN IF EXISTS trigger;
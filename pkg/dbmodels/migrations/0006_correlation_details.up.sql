@@ -0,0 +1,23 @@
+ALTER TABLE correlations ADD COLUMN trigger text NOT NULL DEFAULT '';
+ALTER TABLE correlations ADD COLUMN operation text NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS reconcile_results (
+    id             uuid DEFAULT uuid_generate_v4(),
+    created_at     timestamptz NOT NULL,
+    created_by_id  uuid,
+    updated_by_id  uuid,
+    updated_at     timestamptz NOT NULL,
+    correlation_id uuid NOT NULL,
+    system_id      uuid NOT NULL,
+    team_id        uuid NOT NULL,
+    success        boolean NOT NULL,
+    message        text NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_reconcile_results_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_reconcile_results_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_reconcile_results_correlation FOREIGN KEY (correlation_id) REFERENCES correlations (id),
+    CONSTRAINT fk_reconcile_results_system FOREIGN KEY (system_id) REFERENCES systems (id),
+    CONSTRAINT fk_reconcile_results_team FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS reconcile_results_correlation_system_team_key ON reconcile_results (correlation_id, system_id, team_id);
+CREATE INDEX IF NOT EXISTS idx_reconcile_results_created_at ON reconcile_results (created_at);
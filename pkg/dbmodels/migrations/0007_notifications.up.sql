@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS notifications (
+    id            uuid DEFAULT uuid_generate_v4(),
+    created_at    timestamptz NOT NULL,
+    created_by_id uuid,
+    updated_by_id uuid,
+    updated_at    timestamptz NOT NULL,
+    team_id       uuid NOT NULL,
+    key           text NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_notifications_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_notifications_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_notifications_team FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS notifications_team_key ON notifications (team_id, key);
+CREATE INDEX IF NOT EXISTS idx_notifications_created_at ON notifications (created_at);
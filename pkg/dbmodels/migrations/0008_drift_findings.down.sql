@@ -0,0 +1 @@
+DROP TABLE IF EXISTS drift_findings;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS drift_findings (
+    id            uuid DEFAULT uuid_generate_v4(),
+    created_at    timestamptz NOT NULL,
+    created_by_id uuid,
+    updated_by_id uuid,
+    updated_at    timestamptz NOT NULL,
+    system_id     uuid NOT NULL,
+    team_id       uuid NOT NULL,
+    kind          text NOT NULL,
+    resource      text NOT NULL,
+    subject       text NOT NULL,
+    message       text NOT NULL,
+    auto_correct  boolean NOT NULL DEFAULT false,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_drift_findings_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_drift_findings_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_drift_findings_system FOREIGN KEY (system_id) REFERENCES systems (id),
+    CONSTRAINT fk_drift_findings_team FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS drift_findings_key ON drift_findings (system_id, team_id, kind, resource, subject);
+CREATE INDEX IF NOT EXISTS idx_drift_findings_created_at ON drift_findings (created_at);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS reconcile_queue_entries (
+    id             uuid DEFAULT uuid_generate_v4(),
+    created_at     timestamptz NOT NULL,
+    created_by_id  uuid,
+    updated_by_id  uuid,
+    updated_at     timestamptz NOT NULL,
+    correlation_id uuid NOT NULL,
+    team_id        uuid NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_reconcile_queue_entries_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_reconcile_queue_entries_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_reconcile_queue_entries_correlation FOREIGN KEY (correlation_id) REFERENCES correlations (id),
+    CONSTRAINT fk_reconcile_queue_entries_team FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE INDEX IF NOT EXISTS idx_reconcile_queue_entries_created_at ON reconcile_queue_entries (created_at);
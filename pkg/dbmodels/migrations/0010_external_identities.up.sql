@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS external_identities (
+    id            uuid DEFAULT uuid_generate_v4(),
+    created_at    timestamptz NOT NULL,
+    created_by_id uuid,
+    updated_by_id uuid,
+    updated_at    timestamptz NOT NULL,
+    system_id     uuid NOT NULL,
+    email         text NOT NULL,
+    username      text NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_external_identities_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_external_identities_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_external_identities_system FOREIGN KEY (system_id) REFERENCES systems (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS external_identities_email ON external_identities (system_id, email);
+CREATE INDEX IF NOT EXISTS external_identities_username ON external_identities (system_id, username);
+CREATE INDEX IF NOT EXISTS idx_external_identities_created_at ON external_identities (created_at);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS team_repositories (
+    id            uuid DEFAULT uuid_generate_v4(),
+    created_at    timestamptz NOT NULL,
+    created_by_id uuid,
+    updated_by_id uuid,
+    updated_at    timestamptz NOT NULL,
+    team_id       uuid NOT NULL,
+    name          text NOT NULL,
+    permission    text NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_team_repositories_created_by FOREIGN KEY (created_by_id) REFERENCES users (id),
+    CONSTRAINT fk_team_repositories_updated_by FOREIGN KEY (updated_by_id) REFERENCES users (id),
+    CONSTRAINT fk_team_repositories_team FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS team_repository ON team_repositories (team_id, name);
+CREATE INDEX IF NOT EXISTS idx_team_repositories_created_at ON team_repositories (created_at);
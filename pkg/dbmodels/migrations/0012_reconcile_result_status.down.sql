@@ -0,0 +1 @@
+ALTER TABLE reconcile_results DROP COLUMN IF EXISTS status;
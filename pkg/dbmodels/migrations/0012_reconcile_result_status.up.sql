@@ -0,0 +1,2 @@
+ALTER TABLE reconcile_results ADD COLUMN IF NOT EXISTS status text NOT NULL DEFAULT 'succeeded';
+UPDATE reconcile_results SET status = 'failed' WHERE NOT success;
@@ -0,0 +1,2 @@
+ALTER TABLE notifications DROP COLUMN IF EXISTS sent_at;
+ALTER TABLE notifications DROP COLUMN IF EXISTS failures;
@@ -0,0 +1,3 @@
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS failures integer NOT NULL DEFAULT 0;
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS sent_at timestamptz;
+UPDATE notifications SET sent_at = updated_at;
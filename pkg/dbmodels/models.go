@@ -1,6 +1,7 @@
 package dbmodels
 
 import (
+	"errors"
 	"github.com/jackc/pgtype"
 	log "github.com/sirupsen/logrus"
 	"time"
@@ -35,21 +36,27 @@ type ApiKey struct {
 	UserID uuid.UUID `gorm:"type:uuid; not null"`
 }
 
+// AuditLog An entry in the audit log. Entries form a hash chain ordered by Sequence, where the hash of each entry covers
+// its content and the hash of the previous entry, so modified or removed entries can be detected. Entries can not be
+// deleted.
 type AuditLog struct {
 	Model
-	SoftDelete
-	Actor          *User       `gorm:""` // The user or service account that performed the action
-	Correlation    Correlation `gorm:""`
-	TargetSystem   System      `gorm:""`
-	TargetTeam     *Team       `gorm:""` // The team, if any, that was the target of the action
-	TargetUser     *User       `gorm:""` // The user, if any, that was the target of the action
-	ActorID        *uuid.UUID  `gorm:"type:uuid"`
-	CorrelationID  uuid.UUID   `gorm:"type:uuid; not null"`
-	TargetSystemID uuid.UUID   `gorm:"type:uuid; not null"`
-	TargetTeamID   *uuid.UUID  `gorm:"type:uuid"`
-	TargetUserID   *uuid.UUID  `gorm:"type:uuid"`
-	Action         string      `gorm:"not null; index"`
-	Message        string      `gorm:"not null"` // Human readable message (log line)
+	Actor          *User        `gorm:""` // The user or service account that performed the action
+	Correlation    Correlation  `gorm:""`
+	TargetSystem   System       `gorm:""`
+	TargetTeam     *Team        `gorm:""` // The team, if any, that was the target of the action
+	TargetUser     *User        `gorm:""` // The user, if any, that was the target of the action
+	ActorID        *uuid.UUID   `gorm:"type:uuid"`
+	CorrelationID  uuid.UUID    `gorm:"type:uuid; not null"`
+	TargetSystemID uuid.UUID    `gorm:"type:uuid; not null"`
+	TargetTeamID   *uuid.UUID   `gorm:"type:uuid"`
+	TargetUserID   *uuid.UUID   `gorm:"type:uuid"`
+	Action         string       `gorm:"not null; index"`
+	Message        string       `gorm:"not null"`                 // Human readable message (log line)
+	Details        pgtype.JSONB `gorm:"type:jsonb; default:null"` // Structured details about the action, if any
+	Sequence       int64        `gorm:"not null; unique"`
+	PreviousHash   string       `gorm:"not null"`
+	Hash           string       `gorm:"not null"`
 }
 
 type Authorization struct {
@@ -57,9 +64,12 @@ type Authorization struct {
 	Name string `gorm:"unique; not null"`
 }
 
+// Correlation Groups all effects of a single change. CreatedBy is the user that initiated the change, if any.
 type Correlation struct {
 	Model
 	SoftDelete
+	Trigger   CorrelationTrigger `gorm:"not null; default:''"`
+	Operation string             `gorm:"not null; default:''"` // Name of the mutation or command that triggered the change
 }
 
 type ReconcileError struct {
@@ -74,6 +84,65 @@ type ReconcileError struct {
 	Message       string      `gorm:"not null"` // Human readable error message
 }
 
+// DriftFinding A difference between an external system and the desired state of a team, found by the last drift scan
+// of the team. CreatedAt is the time the difference was first found, and UpdatedAt the time it was last seen.
+type DriftFinding struct {
+	Model
+	System      System    `gorm:""`
+	Team        Team      `gorm:""`
+	SystemID    uuid.UUID `gorm:"type:uuid; uniqueIndex:drift_findings_key; not null"`
+	TeamID      uuid.UUID `gorm:"type:uuid; uniqueIndex:drift_findings_key; not null"`
+	Kind        DriftKind `gorm:"uniqueIndex:drift_findings_key; not null"`
+	Resource    string    `gorm:"uniqueIndex:drift_findings_key; not null"` // The external resource, for instance a group
+	Subject     string    `gorm:"uniqueIndex:drift_findings_key; not null"` // What differs, for instance a member
+	Message     string    `gorm:"not null"`                                 // Human readable description
+	AutoCorrect bool      `gorm:"not null"`                                 // Whether a reconcile of the team was scheduled to correct the drift
+}
+
+// Notification State of a notification to a team. Used to count failures before notifying, and to avoid sending the
+// same notification repeatedly.
+type Notification struct {
+	Model
+	Team     Team       `gorm:""`
+	TeamID   uuid.UUID  `gorm:"type:uuid; uniqueIndex:notifications_team_key; not null"`
+	Key      string     `gorm:"uniqueIndex:notifications_team_key; not null"` // Identifies what the notification was about
+	Failures int        `gorm:"not null; default:0"`                          // Failed reconciles in a row, for reconcile failure notifications
+	SentAt   *time.Time `gorm:""`                                             // When the notification was last sent, nil if it has not been sent
+}
+
+// ReconcileResult Outcome of the most recent run of a reconciler for a team within a correlation
+type ReconcileResult struct {
+	Model
+	Correlation   Correlation     `gorm:""`
+	System        System          `gorm:""`
+	Team          Team            `gorm:""`
+	CorrelationID uuid.UUID       `gorm:"type:uuid; uniqueIndex:reconcile_results_correlation_system_team_key; not null"`
+	SystemID      uuid.UUID       `gorm:"type:uuid; uniqueIndex:reconcile_results_correlation_system_team_key; not null"`
+	TeamID        uuid.UUID       `gorm:"type:uuid; uniqueIndex:reconcile_results_correlation_system_team_key; not null"`
+	Success       bool            `gorm:"not null"`
+	Status        ReconcileStatus `gorm:"not null; default:'succeeded'"`
+	Message       string          `gorm:"not null"` // Error message when the reconciler failed
+}
+
+// ReconcileQueueEntry A request to reconcile a team, handed over to the leader by a replica that is not the leader
+type ReconcileQueueEntry struct {
+	Model
+	Correlation   Correlation `gorm:""`
+	Team          Team        `gorm:""`
+	CorrelationID uuid.UUID   `gorm:"type:uuid; not null"`
+	TeamID        uuid.UUID   `gorm:"type:uuid; not null"`
+}
+
+// ExternalIdentity The account of a user in an external system, cached from the external system so it does not have to
+// be looked up for every reconcile. UpdatedAt is the time the identity was last fetched.
+type ExternalIdentity struct {
+	Model
+	System   System    `gorm:""`
+	SystemID uuid.UUID `gorm:"type:uuid; uniqueIndex:external_identities_email; index:external_identities_username; not null"`
+	Email    string    `gorm:"uniqueIndex:external_identities_email; not null"` // Lowercase email address of the user
+	Username string    `gorm:"index:external_identities_username; not null"`    // Username of the account in the external system
+}
+
 type Role struct {
 	Model
 	Name           string          `gorm:"unique; not null"`
@@ -110,6 +179,15 @@ type TeamMetadata struct {
 	Value  *string   `gorm:""`
 }
 
+// TeamRepository A repository in the GitHub organization that the team should have access to
+type TeamRepository struct {
+	Model
+	Team       Team                 `gorm:""`
+	TeamID     uuid.UUID            `gorm:"type:uuid; not null; uniqueIndex:team_repository"`
+	Name       string               `gorm:"uniqueIndex:team_repository; not null"` // Name of the repository, without the organization
+	Permission RepositoryPermission `gorm:"not null"`
+}
+
 type Team struct {
 	Model
 	SoftDelete
@@ -157,6 +235,14 @@ func (s *SoftDelete) GetSoftDeleteModel() *SoftDelete {
 	return s
 }
 
+// ErrAuditLogImmutable Returned when trying to delete audit log entries
+var ErrAuditLogImmutable = errors.New("audit log entries can not be deleted")
+
+// BeforeDelete Prevent audit log entries from being deleted through gorm
+func (a *AuditLog) BeforeDelete(_ *gorm.DB) error {
+	return ErrAuditLogImmutable
+}
+
 // Error Get the err message from the audit log
 func (a *AuditLog) Error() string {
 	return a.Message
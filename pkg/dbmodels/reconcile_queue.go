@@ -0,0 +1,55 @@
+package dbmodels
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EnqueueReconcile Ask the leader to reconcile a team
+func EnqueueReconcile(db *gorm.DB, correlationId, teamId uuid.UUID) error {
+	err := db.Create(&ReconcileQueueEntry{
+		CorrelationID: correlationId,
+		TeamID:        teamId,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("queue reconcile of team: %w", err)
+	}
+
+	return nil
+}
+
+// DequeueReconciles Remove all queued reconciles from the queue, and return them oldest first along with their
+// correlation and team. The team is loaded with its users and metadata, ready to be reconciled.
+func DequeueReconciles(db *gorm.DB) ([]*ReconcileQueueEntry, error) {
+	entries := make([]*ReconcileQueueEntry, 0)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Preload("Correlation").
+			Preload("Team").
+			Preload("Team.Users").
+			Preload("Team.Metadata").
+			Order("created_at ASC").
+			Find(&entries).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if len(entries) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(entries))
+		for i, entry := range entries {
+			ids[i] = *entry.ID
+		}
+
+		return tx.Where("id IN (?)", ids).Delete(&ReconcileQueueEntry{}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dequeue reconciles: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,45 @@
+package dbmodels
+
+import (
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestReconcileQueue(t *testing.T) {
+	db := test.GetTestDB()
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	db.AutoMigrate(User{}, Team{}, UserTeam{}, TeamMetadata{}, Correlation{}, ReconcileQueueEntry{})
+
+	user := &User{Email: "user@example.com", Name: "User"}
+	first := &Team{Slug: "first", Name: "First"}
+	second := &Team{Slug: "second", Name: "Second"}
+	corr := &Correlation{}
+	db.Create(user)
+	db.Create(first)
+	db.Create(second)
+	db.Create(corr)
+	db.Create(&UserTeam{UserID: *user.ID, TeamID: *second.ID})
+
+	entries, err := DequeueReconciles(db)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	assert.NoError(t, EnqueueReconcile(db, *corr.ID, *second.ID))
+	time.Sleep(time.Millisecond)
+	assert.NoError(t, EnqueueReconcile(db, *corr.ID, *first.ID))
+
+	entries, err = DequeueReconciles(db)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, Slug("second"), entries[0].Team.Slug)
+	assert.Len(t, entries[0].Team.Users, 1)
+	assert.Equal(t, *corr.ID, *entries[0].Correlation.ID)
+	assert.Equal(t, Slug("first"), entries[1].Team.Slug)
+
+	entries, err = DequeueReconciles(db)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -0,0 +1,85 @@
+package dbmodels
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ReconcileStatus The outcome of running a reconciler for a team
+type ReconcileStatus string
+
+const (
+	ReconcileStatusSucceeded ReconcileStatus = "succeeded" // The reconciler finished without errors
+	ReconcileStatusFailed    ReconcileStatus = "failed"    // The reconciler failed, and will be retried
+	ReconcileStatusConflict  ReconcileStatus = "conflict"  // The reconciler found a conflicting resource, and needs manual intervention
+)
+
+var reconcileStatuses = []ReconcileStatus{
+	ReconcileStatusSucceeded,
+	ReconcileStatusFailed,
+	ReconcileStatusConflict,
+}
+
+// ErrReconcileConflict Reconcilers wrap this error when a resource can not be created because a resource with the same
+// name already exists, and is not managed by Console
+var ErrReconcileConflict = errors.New("conflicts with an existing resource")
+
+// MarshalGQL Write the status as a GraphQL enum value
+func (s ReconcileStatus) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(strings.ToUpper(string(s))))
+}
+
+// UnmarshalGQL Parse the status from a GraphQL enum value
+func (s *ReconcileStatus) UnmarshalGQL(v interface{}) error {
+	value, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("reconcile status must be a string")
+	}
+
+	for _, status := range reconcileStatuses {
+		if strings.ToUpper(string(status)) == value {
+			*s = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is not a valid ReconcileStatus", value)
+}
+
+// SetReconcileResult Record the outcome of running a reconciler for a team within a correlation. A failed reconciler is
+// retried using the same correlation, so an existing result is overwritten by the outcome of the latest run.
+func SetReconcileResult(db *gorm.DB, correlationId, systemId, teamId uuid.UUID, reconcileErr error) error {
+	result := &ReconcileResult{
+		CorrelationID: correlationId,
+		SystemID:      systemId,
+		TeamID:        teamId,
+	}
+
+	err := db.Where("correlation_id = ? AND system_id = ? AND team_id = ?", correlationId, systemId, teamId).FirstOrCreate(result).Error
+	if err != nil {
+		return fmt.Errorf("get reconcile result: %w", err)
+	}
+
+	result.Success = reconcileErr == nil
+	result.Status = ReconcileStatusSucceeded
+	result.Message = ""
+	if reconcileErr != nil {
+		result.Status = ReconcileStatusFailed
+		if errors.Is(reconcileErr, ErrReconcileConflict) {
+			result.Status = ReconcileStatusConflict
+		}
+		result.Message = reconcileErr.Error()
+	}
+
+	err = db.Save(result).Error
+	if err != nil {
+		return fmt.Errorf("reconcile result not persisted: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package dbmodels
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSetReconcileResult(t *testing.T) {
+	db := test.GetTestDB()
+	db.AutoMigrate(ReconcileResult{})
+
+	correlationId := newUuid()
+	systemId := newUuid()
+	teamId := newUuid()
+
+	assert.NoError(t, SetReconcileResult(db, correlationId, systemId, teamId, errors.New("some error")))
+
+	result := &ReconcileResult{}
+	assert.NoError(t, db.Where("correlation_id = ?", correlationId).First(result).Error)
+	assert.False(t, result.Success)
+	assert.Equal(t, ReconcileStatusFailed, result.Status)
+	assert.Equal(t, "some error", result.Message)
+
+	assert.NoError(t, SetReconcileResult(db, correlationId, systemId, teamId, fmt.Errorf("%w: team exists", ErrReconcileConflict)))
+	assert.NoError(t, db.Where("correlation_id = ?", correlationId).First(result).Error)
+	assert.Equal(t, ReconcileStatusConflict, result.Status)
+
+	assert.NoError(t, SetReconcileResult(db, correlationId, systemId, teamId, nil))
+
+	results := make([]*ReconcileResult, 0)
+	assert.NoError(t, db.Where("correlation_id = ?", correlationId).Find(&results).Error)
+	assert.Len(t, results, 1)
+	assert.True(t, results[0].Success)
+	assert.Equal(t, ReconcileStatusSucceeded, results[0].Status)
+	assert.Empty(t, results[0].Message)
+}
@@ -0,0 +1,60 @@
+package dbmodels
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// RepositoryPermission The access a team has to a repository
+type RepositoryPermission string
+
+const (
+	RepositoryPermissionRead     RepositoryPermission = "read"     // Pull, and open and comment on issues and pull requests
+	RepositoryPermissionTriage   RepositoryPermission = "triage"   // Read, and manage issues and pull requests
+	RepositoryPermissionWrite    RepositoryPermission = "write"    // Triage, and push
+	RepositoryPermissionMaintain RepositoryPermission = "maintain" // Write, and manage the repository without access to sensitive or destructive actions
+	RepositoryPermissionAdmin    RepositoryPermission = "admin"    // Full access to the repository
+)
+
+var repositoryNameRegex = regexp.MustCompile("^[A-Za-z0-9_.-]+$")
+
+var repositoryPermissions = []RepositoryPermission{
+	RepositoryPermissionRead,
+	RepositoryPermissionTriage,
+	RepositoryPermissionWrite,
+	RepositoryPermissionMaintain,
+	RepositoryPermissionAdmin,
+}
+
+// MarshalGQL Write the permission as a GraphQL enum value
+func (p RepositoryPermission) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(strings.ToUpper(string(p))))
+}
+
+// UnmarshalGQL Parse the permission from a GraphQL enum value
+func (p *RepositoryPermission) UnmarshalGQL(v interface{}) error {
+	value, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("repository permission must be a string")
+	}
+
+	for _, permission := range repositoryPermissions {
+		if strings.ToUpper(string(permission)) == value {
+			*p = permission
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is not a valid RepositoryPermission", value)
+}
+
+// ValidateRepositoryName Check that name is a valid name for a GitHub repository
+func ValidateRepositoryName(name string) error {
+	if !repositoryNameRegex.MatchString(name) || name == "." || name == ".." {
+		return fmt.Errorf("'%s' is not a valid repository name", name)
+	}
+	return nil
+}
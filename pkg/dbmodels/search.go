@@ -0,0 +1,7 @@
+package dbmodels
+
+// IsSearchResult Teams can be returned from the GraphQL search query
+func (Team) IsSearchResult() {}
+
+// IsSearchResult Users can be returned from the GraphQL search query
+func (User) IsSearchResult() {}
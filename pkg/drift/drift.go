@@ -0,0 +1,184 @@
+package drift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/config"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/reconcilers"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// OpCorrect Operation of correlations created when scheduling a reconcile to correct drift
+const OpCorrect = "drift:correct"
+
+var (
+	ErrNotEnabled = errors.New("disabled by configuration")
+)
+
+// Policy What to do when drift is found in a system
+type Policy string
+
+const (
+	PolicyReport      Policy = "report"       // Record the drift, without changing the external system
+	PolicyAutoCorrect Policy = "auto-correct" // Record the drift, and schedule a reconcile of the team if that corrects it
+)
+
+// Result Outcome of a drift scan
+type Result struct {
+	Teams       int                 // Number of teams scanned
+	Findings    int                 // Number of differences found
+	Errors      int                 // Number of scans of a single team in a single system that failed
+	Corrections []reconcilers.Input // Teams that should be reconciled to correct drift
+}
+
+type scanner struct {
+	db        *gorm.DB
+	detectors map[dbmodels.System]reconcilers.DriftDetector
+	policies  map[string]Policy
+	now       func() time.Time
+}
+
+// New Create a drift scanner for the reconcilers that are able to detect drift. Systems not present in policies are
+// scanned with PolicyReport.
+func New(db *gorm.DB, recs []reconcilers.Reconciler, policies map[string]Policy) *scanner {
+	detectors := make(map[dbmodels.System]reconcilers.DriftDetector)
+	for _, rec := range recs {
+		if detector, ok := rec.(reconcilers.DriftDetector); ok {
+			detectors[rec.System()] = detector
+		}
+	}
+
+	return &scanner{
+		db:        db,
+		detectors: detectors,
+		policies:  policies,
+		now:       time.Now,
+	}
+}
+
+func NewFromConfig(cfg *config.Config, db *gorm.DB, recs []reconcilers.Reconciler) (*scanner, error) {
+	if !cfg.Drift.Enabled {
+		return nil, ErrNotEnabled
+	}
+
+	if cfg.Drift.Interval <= 0 {
+		return nil, fmt.Errorf("the drift scan interval must be positive")
+	}
+
+	known := make(map[string]bool)
+	for _, rec := range recs {
+		known[rec.System().Name] = true
+	}
+
+	policies := make(map[string]Policy)
+	for _, name := range cfg.Drift.AutoCorrect {
+		if !known[name] {
+			log.Warnf("Drift auto-correction configured for system '%s', which has no enabled reconciler", name)
+		}
+		policies[name] = PolicyAutoCorrect
+	}
+
+	return New(db, recs, policies), nil
+}
+
+// Scan Compare the external systems with the desired state of all teams, and replace the stored findings of each team
+// with the differences found. Teams with correctable drift in a system with PolicyAutoCorrect are returned in the
+// result, with a new correlation, so they can be reconciled. Drift that reconciling does not correct is only recorded,
+// so the team is not reconciled over and over again.
+func (s *scanner) Scan(ctx context.Context) (*Result, error) {
+	teams := make([]*dbmodels.Team, 0)
+	err := s.db.WithContext(ctx).Preload("Users").Preload("Metadata").Find(&teams).Error
+	if err != nil {
+		return nil, fmt.Errorf("get teams: %w", err)
+	}
+
+	result := &Result{
+		Corrections: make([]reconcilers.Input, 0),
+	}
+
+	for _, team := range teams {
+		result.Teams++
+		correct := false
+
+		for system, detector := range s.detectors {
+			drift, err := detector.DetectDrift(ctx, *team)
+			if err != nil {
+				log.Warnf("unable to detect drift for team '%s' in system '%s': %s", team.Slug, system.Name, err)
+				result.Errors++
+				continue
+			}
+
+			autoCorrect := s.policies[system.Name] == PolicyAutoCorrect
+			err = s.store(ctx, system, *team, drift, autoCorrect)
+			if err != nil {
+				return nil, fmt.Errorf("store drift findings for team '%s' in system '%s': %w", team.Slug, system.Name, err)
+			}
+
+			result.Findings += len(drift)
+			for _, d := range drift {
+				correct = correct || (autoCorrect && d.Correctable)
+			}
+		}
+
+		if !correct {
+			continue
+		}
+
+		corr := &dbmodels.Correlation{
+			Trigger:   dbmodels.CorrelationTriggerDrift,
+			Operation: OpCorrect,
+		}
+		err = s.db.WithContext(ctx).Create(corr).Error
+		if err != nil {
+			return nil, fmt.Errorf("create correlation for drift correction of team '%s': %w", team.Slug, err)
+		}
+
+		result.Corrections = append(result.Corrections, reconcilers.Input{
+			Corr: *corr,
+			Team: *team,
+		})
+	}
+
+	return result, nil
+}
+
+// store Replace the findings of a team in a system. Findings that were also present in the previous scan keep their
+// creation time, which is the time the drift was first detected.
+func (s *scanner) store(ctx context.Context, system dbmodels.System, team dbmodels.Team, drift []reconcilers.Drift, autoCorrect bool) error {
+	now := s.now()
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		keep := make([]uuid.UUID, 0, len(drift))
+		for _, d := range drift {
+			finding := &dbmodels.DriftFinding{
+				SystemID: *system.ID,
+				TeamID:   *team.ID,
+				Kind:     d.Kind,
+				Resource: d.Resource,
+				Subject:  d.Subject,
+			}
+			err := tx.
+				Where("system_id = ? AND team_id = ? AND kind = ? AND resource = ? AND subject = ?", system.ID, team.ID, d.Kind, d.Resource, d.Subject).
+				Assign(map[string]interface{}{"updated_at": now, "message": d.Message, "auto_correct": autoCorrect && d.Correctable}).
+				FirstOrCreate(finding).
+				Error
+			if err != nil {
+				return err
+			}
+			keep = append(keep, *finding.ID)
+		}
+
+		query := tx.Where("system_id = ? AND team_id = ?", system.ID, team.ID)
+		if len(keep) > 0 {
+			query = query.Where("id NOT IN (?)", keep)
+		}
+
+		return query.Delete(&dbmodels.DriftFinding{}).Error
+	})
+}
@@ -0,0 +1,170 @@
+package drift_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/drift"
+	"github.com/nais/console/pkg/reconcilers"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type fakeReconciler struct {
+	system dbmodels.System
+	drift  []reconcilers.Drift
+	err    error
+}
+
+func (r *fakeReconciler) System() dbmodels.System {
+	return r.system
+}
+
+func (r *fakeReconciler) Reconcile(ctx context.Context, input reconcilers.Input) error {
+	return errors.New("drift scans must not reconcile")
+}
+
+func (r *fakeReconciler) DetectDrift(ctx context.Context, team dbmodels.Team) ([]reconcilers.Drift, error) {
+	return r.drift, r.err
+}
+
+func setup() (*gorm.DB, *fakeReconciler, *fakeReconciler, dbmodels.Team) {
+	db := test.GetTestDB()
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Team{}, &dbmodels.UserTeam{}, &dbmodels.TeamMetadata{}, &dbmodels.System{}, &dbmodels.Correlation{}, &dbmodels.DriftFinding{})
+
+	github := &fakeReconciler{system: dbmodels.System{Name: "github:team"}}
+	azure := &fakeReconciler{system: dbmodels.System{Name: "azure:group"}}
+	team := dbmodels.Team{Slug: "team", Name: "Team"}
+	db.Create(&github.system)
+	db.Create(&azure.system)
+	db.Create(&team)
+
+	return db, github, azure, team
+}
+
+func findings(db *gorm.DB) []*dbmodels.DriftFinding {
+	result := make([]*dbmodels.DriftFinding, 0)
+	db.Order("subject ASC").Find(&result)
+	return result
+}
+
+func TestScan(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("report only", func(t *testing.T) {
+		db, github, azure, team := setup()
+		github.drift = []reconcilers.Drift{
+			{Kind: dbmodels.DriftKindExtraMember, Resource: "team", Subject: "intruder", Message: "extra"},
+			{Kind: dbmodels.DriftKindMissingMember, Resource: "team", Subject: "member", Message: "missing"},
+		}
+		azure.drift = []reconcilers.Drift{}
+
+		result, err := drift.New(db, []reconcilers.Reconciler{github, azure}, nil).Scan(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Teams)
+		assert.Equal(t, 2, result.Findings)
+		assert.Empty(t, result.Corrections)
+
+		stored := findings(db)
+		assert.Len(t, stored, 2)
+		assert.Equal(t, *team.ID, stored[0].TeamID)
+		assert.Equal(t, *github.system.ID, stored[0].SystemID)
+		assert.Equal(t, "intruder", stored[0].Subject)
+		assert.False(t, stored[0].AutoCorrect)
+	})
+
+	t.Run("findings are replaced, keeping the first detection time", func(t *testing.T) {
+		db, github, azure, _ := setup()
+		azure.drift = []reconcilers.Drift{}
+		github.drift = []reconcilers.Drift{
+			{Kind: dbmodels.DriftKindExtraMember, Resource: "team", Subject: "intruder", Message: "extra"},
+			{Kind: dbmodels.DriftKindMissingMember, Resource: "team", Subject: "member", Message: "missing"},
+		}
+		scanner := drift.New(db, []reconcilers.Reconciler{github, azure}, nil)
+
+		_, err := scanner.Scan(ctx)
+		assert.NoError(t, err)
+		firstDetected := findings(db)[0].CreatedAt
+
+		time.Sleep(10 * time.Millisecond)
+		github.drift = github.drift[:1]
+		_, err = scanner.Scan(ctx)
+		assert.NoError(t, err)
+
+		stored := findings(db)
+		assert.Len(t, stored, 1)
+		assert.Equal(t, "intruder", stored[0].Subject)
+		assert.True(t, stored[0].CreatedAt.Equal(firstDetected))
+		assert.True(t, stored[0].UpdatedAt.After(firstDetected))
+
+		github.drift = []reconcilers.Drift{}
+		_, err = scanner.Scan(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, findings(db))
+	})
+
+	t.Run("findings are kept when detection fails", func(t *testing.T) {
+		db, github, azure, _ := setup()
+		azure.drift = []reconcilers.Drift{}
+		github.drift = []reconcilers.Drift{
+			{Kind: dbmodels.DriftKindMissingResource, Resource: "team", Message: "missing"},
+		}
+		scanner := drift.New(db, []reconcilers.Reconciler{github, azure}, nil)
+
+		_, err := scanner.Scan(ctx)
+		assert.NoError(t, err)
+
+		github.err = errors.New("rate limited")
+		result, err := scanner.Scan(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Errors)
+		assert.Len(t, findings(db), 1)
+	})
+
+	t.Run("auto-correct", func(t *testing.T) {
+		db, github, azure, team := setup()
+		github.drift = []reconcilers.Drift{
+			{Kind: dbmodels.DriftKindExtraMember, Resource: "team", Subject: "intruder", Message: "extra"},
+		}
+		azure.drift = []reconcilers.Drift{
+			{Kind: dbmodels.DriftKindMissingMember, Resource: "nais-team-team", Subject: "user@example.com", Message: "missing", Correctable: true},
+		}
+
+		policies := map[string]drift.Policy{
+			"azure:group": drift.PolicyAutoCorrect,
+		}
+		result, err := drift.New(db, []reconcilers.Reconciler{github, azure}, policies).Scan(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, result.Corrections, 1)
+		assert.Equal(t, *team.ID, *result.Corrections[0].Team.ID)
+		assert.Equal(t, dbmodels.CorrelationTriggerDrift, result.Corrections[0].Corr.Trigger)
+		assert.NotNil(t, result.Corrections[0].Corr.ID)
+
+		for _, finding := range findings(db) {
+			assert.Equal(t, finding.SystemID == *azure.system.ID, finding.AutoCorrect)
+		}
+	})
+
+	t.Run("drift that reconciling does not correct is only reported", func(t *testing.T) {
+		db, github, azure, _ := setup()
+		github.drift = []reconcilers.Drift{}
+		azure.drift = []reconcilers.Drift{
+			{Kind: dbmodels.DriftKindRenamed, Resource: "nais-team-team", Subject: "renamed", Message: "renamed"},
+		}
+
+		policies := map[string]drift.Policy{
+			"azure:group": drift.PolicyAutoCorrect,
+		}
+		result, err := drift.New(db, []reconcilers.Reconciler{github, azure}, policies).Scan(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, result.Corrections)
+		assert.Equal(t, 1, result.Findings)
+		assert.False(t, findings(db)[0].AutoCorrect)
+	})
+}
@@ -0,0 +1,45 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Type The kind of event
+type Type string
+
+const (
+	TypeTeamSyncStarted  Type = "team_sync_started"
+	TypeTeamSyncFinished Type = "team_sync_finished"
+	TypeTeamSyncFailed   Type = "team_sync_failed"
+	TypeAuditLogCreated  Type = "audit_log_created"
+)
+
+// Event Something that happened to a team. Events are kept small, as they are sent as PostgreSQL notification payloads.
+// Subscribers are expected to look up any related objects in the database.
+type Event struct {
+	Type          Type       `json:"type"`
+	TeamID        uuid.UUID  `json:"teamId"`
+	CorrelationID uuid.UUID  `json:"correlationId"`
+	SystemID      *uuid.UUID `json:"systemId,omitempty"`
+	AuditLogID    *uuid.UUID `json:"auditLogId,omitempty"`
+	Message       string     `json:"message,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+type Subscriber interface {
+	// Subscribe Receive all events for a team. The returned function must be called to end the subscription, after
+	// which the channel is closed.
+	Subscribe(teamID uuid.UUID) (<-chan Event, func())
+}
+
+type Broker interface {
+	Publisher
+	Subscriber
+}
@@ -0,0 +1,77 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+// subscriptionBufferSize Number of events buffered for each subscriber. Events are dropped for subscribers that fall
+// further behind than this, so a slow client can never block the reconcile loop.
+const subscriptionBufferSize = 64
+
+type subscription struct {
+	ch chan Event
+}
+
+// LocalBroker Fan out events to subscribers within this process
+type LocalBroker struct {
+	lock          sync.RWMutex
+	subscriptions map[uuid.UUID]map[*subscription]struct{}
+}
+
+func NewLocal() *LocalBroker {
+	return &LocalBroker{
+		subscriptions: make(map[uuid.UUID]map[*subscription]struct{}),
+	}
+}
+
+// Publish Deliver an event to all subscribers of the event's team
+func (b *LocalBroker) Publish(_ context.Context, event Event) error {
+	b.deliver(event)
+	return nil
+}
+
+func (b *LocalBroker) Subscribe(teamID uuid.UUID) (<-chan Event, func()) {
+	sub := &subscription{
+		ch: make(chan Event, subscriptionBufferSize),
+	}
+
+	b.lock.Lock()
+	if b.subscriptions[teamID] == nil {
+		b.subscriptions[teamID] = make(map[*subscription]struct{})
+	}
+	b.subscriptions[teamID][sub] = struct{}{}
+	b.lock.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.lock.Lock()
+			defer b.lock.Unlock()
+
+			delete(b.subscriptions[teamID], sub)
+			if len(b.subscriptions[teamID]) == 0 {
+				delete(b.subscriptions, teamID)
+			}
+			close(sub.ch)
+		})
+	}
+
+	return sub.ch, unsubscribe
+}
+
+func (b *LocalBroker) deliver(event Event) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	for sub := range b.subscriptions[event.TeamID] {
+		select {
+		case sub.ch <- event:
+		default:
+			log.Warnf("Subscriber for team '%s' is not keeping up, dropping event '%s'", event.TeamID, event.Type)
+		}
+	}
+}
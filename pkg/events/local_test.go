@@ -0,0 +1,53 @@
+package events_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalBroker(t *testing.T) {
+	ctx := context.Background()
+	teamID := uuid.New()
+	otherTeamID := uuid.New()
+
+	t.Run("events are delivered to subscribers of the team", func(t *testing.T) {
+		broker := events.NewLocal()
+		ch, unsubscribe := broker.Subscribe(teamID)
+		defer unsubscribe()
+		otherCh, unsubscribeOther := broker.Subscribe(otherTeamID)
+		defer unsubscribeOther()
+
+		assert.NoError(t, broker.Publish(ctx, events.Event{Type: events.TypeTeamSyncStarted, TeamID: teamID}))
+
+		event := <-ch
+		assert.Equal(t, events.TypeTeamSyncStarted, event.Type)
+		assert.Equal(t, teamID, event.TeamID)
+		assert.Len(t, otherCh, 0)
+	})
+
+	t.Run("unsubscribe closes the channel", func(t *testing.T) {
+		broker := events.NewLocal()
+		ch, unsubscribe := broker.Subscribe(teamID)
+		unsubscribe()
+		unsubscribe()
+
+		_, open := <-ch
+		assert.False(t, open)
+		assert.NoError(t, broker.Publish(ctx, events.Event{Type: events.TypeTeamSyncStarted, TeamID: teamID}))
+	})
+
+	t.Run("slow subscribers do not block publishers", func(t *testing.T) {
+		broker := events.NewLocal()
+		ch, unsubscribe := broker.Subscribe(teamID)
+		defer unsubscribe()
+
+		for i := 0; i < cap(ch)+10; i++ {
+			assert.NoError(t, broker.Publish(ctx, events.Event{Type: events.TypeAuditLogCreated, TeamID: teamID}))
+		}
+		assert.Len(t, ch, cap(ch))
+	})
+}
@@ -0,0 +1,145 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v4"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// notifyChannel PostgreSQL notification channel used to distribute events between Console replicas
+const notifyChannel = "console_events"
+
+// reconnectInterval Time to wait before reconnecting the listener after losing the database connection
+const reconnectInterval = 5 * time.Second
+
+// maxPayloadSize PostgreSQL rejects notifications with a payload of 8000 bytes or more
+const maxPayloadSize = 7999
+
+// truncatedSuffix Appended to messages that have been shortened to fit in a notification payload
+const truncatedSuffix = " [truncated]"
+
+// PostgresBroker Distribute events to all Console replicas using PostgreSQL LISTEN/NOTIFY. Published events are only
+// delivered to local subscribers once they have made the round trip through the database, so subscribers see the
+// same events regardless of which replica published them.
+type PostgresBroker struct {
+	*LocalBroker
+	db          *gorm.DB
+	databaseURL string
+}
+
+func NewPostgres(db *gorm.DB, databaseURL string) *PostgresBroker {
+	return &PostgresBroker{
+		LocalBroker: NewLocal(),
+		db:          db,
+		databaseURL: databaseURL,
+	}
+}
+
+// Publish Send an event to all replicas listening for events, including this one
+func (b *PostgresBroker) Publish(ctx context.Context, event Event) error {
+	payload, err := encode(event)
+	if err != nil {
+		return fmt.Errorf("encode event: %w", err)
+	}
+
+	err = b.db.WithContext(ctx).Exec("SELECT pg_notify(?, ?)", notifyChannel, string(payload)).Error
+	if err != nil {
+		return fmt.Errorf("publish event: %w", err)
+	}
+
+	return nil
+}
+
+// Run Listen for events from the database and deliver them to local subscribers. Blocks until ctx is canceled, and
+// reconnects if the connection to the database is lost.
+func (b *PostgresBroker) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		err := b.listen(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Errorf("Event listener: %s; reconnecting in %s", err, reconnectInterval)
+			select {
+			case <-ctx.Done():
+			case <-time.After(reconnectInterval):
+			}
+		}
+	}
+}
+
+func (b *PostgresBroker) listen(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, b.databaseURL)
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+notifyChannel)
+	if err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+
+	log.Infof("Listening for events on channel '%s'", notifyChannel)
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		event := Event{}
+		err = json.Unmarshal([]byte(notification.Payload), &event)
+		if err != nil {
+			log.Warnf("Discarding malformed event: %s", err)
+			continue
+		}
+
+		b.deliver(event)
+	}
+}
+
+// encode Encode the event as a notification payload. Messages that would make the payload too large, for instance long
+// error messages, are truncated.
+func encode(event Event) ([]byte, error) {
+	payload, err := json.Marshal(event)
+	if err != nil || len(payload) <= maxPayloadSize {
+		return payload, err
+	}
+
+	// Escaping makes the size of the message in the payload hard to predict, so search for the longest prefix that fits
+	message := event.Message
+	truncate := func(end int) ([]byte, error) {
+		for end > 0 && end < len(message) && !utf8.RuneStart(message[end]) {
+			end--
+		}
+		event.Message = message[:end] + truncatedSuffix
+		return json.Marshal(event)
+	}
+
+	var truncated []byte
+	low, high := 0, len(message)
+	for low <= high {
+		end := (low + high) / 2
+		candidate, err := truncate(end)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(candidate) <= maxPayloadSize {
+			truncated = candidate
+			low = end + 1
+		} else {
+			high = end - 1
+		}
+	}
+
+	if truncated == nil {
+		return nil, fmt.Errorf("event payload of %d bytes is too large", len(payload))
+	}
+
+	return truncated, nil
+}
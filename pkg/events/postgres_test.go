@@ -0,0 +1,41 @@
+package events
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncode(t *testing.T) {
+	t.Run("small events are not changed", func(t *testing.T) {
+		event := Event{Type: TypeTeamSyncFailed, TeamID: uuid.New(), Message: "failed"}
+		payload, err := encode(event)
+		assert.NoError(t, err)
+
+		decoded := Event{}
+		assert.NoError(t, json.Unmarshal(payload, &decoded))
+		assert.Equal(t, "failed", decoded.Message)
+	})
+
+	t.Run("long messages are truncated", func(t *testing.T) {
+		for _, message := range []string{
+			strings.Repeat("a", 10000),
+			strings.Repeat("æ", 10000),
+			strings.Repeat("<\"\n", 10000),
+		} {
+			payload, err := encode(Event{Type: TypeTeamSyncFailed, TeamID: uuid.New(), Message: message})
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, len(payload), maxPayloadSize)
+
+			decoded := Event{}
+			assert.NoError(t, json.Unmarshal(payload, &decoded))
+			assert.True(t, strings.HasSuffix(decoded.Message, truncatedSuffix))
+			assert.True(t, strings.HasPrefix(message, strings.TrimSuffix(decoded.Message, truncatedSuffix)))
+			assert.True(t, utf8.ValidString(decoded.Message))
+		}
+	})
+}
@@ -13,9 +13,15 @@ const (
 )
 
 // InsertInitialDataset Insert an initial dataset into the database. This will only be executed if there are currently
-// no users in the users table.
+// no users in the users table. Roles and authorizations are not part of the dataset, and must be in place before this
+// function is called, see SyncRolesAndAuthorizations.
 func InsertInitialDataset(db *gorm.DB, tenantDomain string, adminApiKey string) error {
 	return db.Transaction(func(tx *gorm.DB) error {
+		err := lock(tx)
+		if err != nil {
+			return err
+		}
+
 		// If there are any users in the database, skip creation
 		users := make([]*dbmodels.User, 0)
 		var numUsers int64
@@ -31,7 +37,7 @@ func InsertInitialDataset(db *gorm.DB, tenantDomain string, adminApiKey string)
 			Email: AdminUserEmailPrefix + "@" + tenantDomain,
 		}
 
-		err := tx.Create(adminUser).Error
+		err = tx.Create(adminUser).Error
 		if err != nil {
 			return err
 		}
@@ -47,11 +53,6 @@ func InsertInitialDataset(db *gorm.DB, tenantDomain string, adminApiKey string)
 			}
 		}
 
-		err = createRolesAndAuthorizations(tx)
-		if err != nil {
-			return err
-		}
-
 		adminRole := &dbmodels.Role{}
 		err = tx.Where("name = ?", roles.RoleAdmin).First(adminRole).Error
 		if err != nil {
@@ -68,98 +69,3 @@ func InsertInitialDataset(db *gorm.DB, tenantDomain string, adminApiKey string)
 		return nil
 	})
 }
-
-func createRolesAndAuthorizations(tx *gorm.DB) error {
-	auditLogsRead := &dbmodels.Authorization{Name: string(roles.AuthorizationAuditLogsRead)}
-	serviceAccountsCreate := &dbmodels.Authorization{Name: string(roles.AuthorizationServiceAccountsCreate)}
-	serviceAccountsDelete := &dbmodels.Authorization{Name: string(roles.AuthorizationServiceAccountsDelete)}
-	serviceAccountList := &dbmodels.Authorization{Name: string(roles.AuthorizationServiceAccountList)}
-	serviceAccountsUpdate := &dbmodels.Authorization{Name: string(roles.AuthorizationServiceAccountsUpdate)}
-	systemStatesDelete := &dbmodels.Authorization{Name: string(roles.AuthorizationSystemStatesDelete)}
-	systemStatesRead := &dbmodels.Authorization{Name: string(roles.AuthorizationSystemStatesRead)}
-	systemStatesUpdate := &dbmodels.Authorization{Name: string(roles.AuthorizationSystemStatesUpdate)}
-	teamsCreate := &dbmodels.Authorization{Name: string(roles.AuthorizationTeamsCreate)}
-	teamsDelete := &dbmodels.Authorization{Name: string(roles.AuthorizationTeamsDelete)}
-	teamsList := &dbmodels.Authorization{Name: string(roles.AuthorizationTeamsList)}
-	teamsRead := &dbmodels.Authorization{Name: string(roles.AuthorizationTeamsRead)}
-	teamsUpdate := &dbmodels.Authorization{Name: string(roles.AuthorizationTeamsUpdate)}
-	usersList := &dbmodels.Authorization{Name: string(roles.AuthorizationUsersList)}
-	authorizations := []*dbmodels.Authorization{
-		auditLogsRead,
-		serviceAccountsCreate,
-		serviceAccountsDelete,
-		serviceAccountList,
-		serviceAccountsUpdate,
-		systemStatesDelete,
-		systemStatesRead,
-		systemStatesUpdate,
-		teamsCreate,
-		teamsDelete,
-		teamsList,
-		teamsRead,
-		teamsUpdate,
-		usersList,
-	}
-	err := tx.Create(authorizations).Error
-	if err != nil {
-		return err
-	}
-
-	roleAdmin := &dbmodels.Role{Name: string(roles.RoleAdmin)}
-	serviceAccountCreator := &dbmodels.Role{Name: string(roles.RoleServiceAccountCreator)}
-	serviceAccountOwner := &dbmodels.Role{Name: string(roles.RoleServiceAccountOwner)}
-	teamCreator := &dbmodels.Role{Name: string(roles.RoleTeamCreator)}
-	teamMember := &dbmodels.Role{Name: string(roles.RoleTeamMember)}
-	teamOwner := &dbmodels.Role{Name: string(roles.RoleTeamOwner)}
-	teamViewer := &dbmodels.Role{Name: string(roles.RoleTeamViewer)}
-	userViewer := &dbmodels.Role{Name: string(roles.RoleUserViewer)}
-
-	err = tx.Create([]*dbmodels.Role{roleAdmin, serviceAccountCreator, serviceAccountOwner, teamCreator, teamMember, teamOwner, teamViewer, userViewer}).Error
-	if err != nil {
-		return err
-	}
-
-	ra := []*dbmodels.RoleAuthorization{
-		{Role: *roleAdmin, Authorization: *auditLogsRead},
-		{Role: *roleAdmin, Authorization: *serviceAccountsCreate},
-		{Role: *roleAdmin, Authorization: *serviceAccountsDelete},
-		{Role: *roleAdmin, Authorization: *serviceAccountList},
-		{Role: *roleAdmin, Authorization: *serviceAccountsUpdate},
-		{Role: *roleAdmin, Authorization: *systemStatesDelete},
-		{Role: *roleAdmin, Authorization: *systemStatesRead},
-		{Role: *roleAdmin, Authorization: *systemStatesUpdate},
-		{Role: *roleAdmin, Authorization: *teamsCreate},
-		{Role: *roleAdmin, Authorization: *teamsDelete},
-		{Role: *roleAdmin, Authorization: *teamsList},
-		{Role: *roleAdmin, Authorization: *teamsRead},
-		{Role: *roleAdmin, Authorization: *teamsUpdate},
-		{Role: *roleAdmin, Authorization: *usersList},
-
-		{Role: *serviceAccountCreator, Authorization: *serviceAccountsCreate},
-
-		{Role: *serviceAccountOwner, Authorization: *serviceAccountsDelete},
-		{Role: *serviceAccountOwner, Authorization: *serviceAccountsUpdate},
-
-		{Role: *teamCreator, Authorization: *teamsCreate},
-
-		{Role: *teamMember, Authorization: *teamsRead},
-		{Role: *teamMember, Authorization: *auditLogsRead},
-
-		{Role: *teamOwner, Authorization: *teamsDelete},
-		{Role: *teamOwner, Authorization: *teamsRead},
-		{Role: *teamOwner, Authorization: *teamsUpdate},
-		{Role: *teamOwner, Authorization: *auditLogsRead},
-
-		{Role: *teamViewer, Authorization: *teamsList},
-		{Role: *teamViewer, Authorization: *teamsRead},
-		{Role: *teamViewer, Authorization: *auditLogsRead},
-
-		{Role: *userViewer, Authorization: *usersList},
-	}
-	err = tx.Create(ra).Error
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
@@ -0,0 +1,22 @@
+package fixtures
+
+import (
+	"fmt"
+
+	"github.com/nais/console/pkg/dbmodels"
+	"gorm.io/gorm"
+)
+
+// lock Hold the fixtures lock for the rest of the transaction
+func lock(tx *gorm.DB) error {
+	if tx.Dialector.Name() != "postgres" {
+		return nil
+	}
+
+	err := tx.Exec("SELECT pg_advisory_xact_lock(?)", dbmodels.FixturesLockID).Error
+	if err != nil {
+		return fmt.Errorf("acquire fixtures lock: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,270 @@
+package fixtures
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/roles"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// RoleGrant A single role => authorization mapping
+type RoleGrant struct {
+	Role          string
+	Authorization string
+}
+
+// RoleDiff Changes made to the database when synchronizing roles and authorizations
+type RoleDiff struct {
+	AddedAuthorizations   []string
+	RemovedAuthorizations []string
+	AddedRoles            []string
+	RemovedRoles          []string
+	Granted               []RoleGrant
+	Revoked               []RoleGrant
+}
+
+// Empty Check if the diff contains no changes
+func (d RoleDiff) Empty() bool {
+	return len(d.AddedAuthorizations)+len(d.RemovedAuthorizations)+len(d.AddedRoles)+len(d.RemovedRoles)+len(d.Granted)+len(d.Revoked) == 0
+}
+
+// Log Write the changes to the standard logger
+func (d RoleDiff) Log() {
+	if d.Empty() {
+		log.Infof("Roles and authorizations are up to date.")
+		return
+	}
+
+	for _, name := range d.AddedAuthorizations {
+		log.Infof("Added authorization '%s'", name)
+	}
+	for _, name := range d.RemovedAuthorizations {
+		log.Infof("Removed stale authorization '%s'", name)
+	}
+	for _, name := range d.AddedRoles {
+		log.Infof("Added role '%s'", name)
+	}
+	for _, name := range d.RemovedRoles {
+		log.Infof("Removed stale role '%s'", name)
+	}
+	for _, grant := range d.Granted {
+		log.Infof("Granted authorization '%s' to role '%s'", grant.Authorization, grant.Role)
+	}
+	for _, grant := range d.Revoked {
+		log.Infof("Revoked authorization '%s' from role '%s'", grant.Authorization, grant.Role)
+	}
+}
+
+// SyncRolesAndAuthorizations Make sure the roles and authorizations in the database match the catalogue in the roles
+// package. Missing roles, authorizations and mappings are added, and mappings no longer in the catalogue are revoked.
+// Roles and authorizations no longer in the catalogue are only removed if removeStale is set. The function is
+// idempotent, and is executed on every startup.
+func SyncRolesAndAuthorizations(db *gorm.DB, removeStale bool) (*RoleDiff, error) {
+	diff := &RoleDiff{}
+	catalogue := roles.Roles
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := lock(tx)
+		if err != nil {
+			return err
+		}
+
+		authorizationIDs, err := syncAuthorizations(tx, roles.Authorizations, removeStale, diff)
+		if err != nil {
+			return err
+		}
+
+		roleIDs, err := syncRoles(tx, catalogue, removeStale, diff)
+		if err != nil {
+			return err
+		}
+
+		existing := make([]*dbmodels.RoleAuthorization, 0)
+		err = tx.Preload("Role").Preload("Authorization").Find(&existing).Error
+		if err != nil {
+			return fmt.Errorf("list role authorizations: %w", err)
+		}
+
+		granted := make(map[RoleGrant]bool)
+		for _, ra := range existing {
+			grant := RoleGrant{Role: ra.Role.Name, Authorization: ra.Authorization.Name}
+			granted[grant] = true
+
+			_, roleKnown := catalogue[roles.Role(grant.Role)]
+			if !roleKnown || hasAuthorization(catalogue[roles.Role(grant.Role)], grant.Authorization) {
+				continue
+			}
+
+			err = tx.Where("role_id = ? AND authorization_id = ?", ra.RoleID, ra.AuthorizationID).Delete(&dbmodels.RoleAuthorization{}).Error
+			if err != nil {
+				return fmt.Errorf("revoke authorization '%s' from role '%s': %w", grant.Authorization, grant.Role, err)
+			}
+			diff.Revoked = append(diff.Revoked, grant)
+		}
+
+		for _, role := range sortedRoles(catalogue) {
+			for _, authorization := range catalogue[role] {
+				grant := RoleGrant{Role: string(role), Authorization: string(authorization)}
+				if granted[grant] {
+					continue
+				}
+
+				authorizationID, exists := authorizationIDs[string(authorization)]
+				if !exists {
+					return fmt.Errorf("role '%s' refers to unknown authorization '%s'", role, authorization)
+				}
+
+				err = tx.Create(&dbmodels.RoleAuthorization{
+					RoleID:          roleIDs[string(role)],
+					AuthorizationID: authorizationID,
+				}).Error
+				if err != nil {
+					return fmt.Errorf("grant authorization '%s' to role '%s': %w", authorization, role, err)
+				}
+				granted[grant] = true
+				diff.Granted = append(diff.Granted, grant)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+// syncAuthorizations Create missing authorizations, and optionally remove stale ones. Returns a map of authorization
+// names to IDs for all authorizations in the catalogue.
+func syncAuthorizations(tx *gorm.DB, authorizations []roles.Authorization, removeStale bool, diff *RoleDiff) (map[string]uuid.UUID, error) {
+	existing := make([]*dbmodels.Authorization, 0)
+	err := tx.Find(&existing).Error
+	if err != nil {
+		return nil, fmt.Errorf("list authorizations: %w", err)
+	}
+
+	ids := make(map[string]uuid.UUID)
+	known := make(map[string]bool)
+	for _, authorization := range authorizations {
+		known[string(authorization)] = true
+	}
+
+	for _, authorization := range existing {
+		if known[authorization.Name] {
+			ids[authorization.Name] = *authorization.ID
+			continue
+		}
+
+		if !removeStale {
+			continue
+		}
+
+		err = tx.Where("authorization_id = ?", authorization.ID).Delete(&dbmodels.RoleAuthorization{}).Error
+		if err != nil {
+			return nil, fmt.Errorf("revoke stale authorization '%s': %w", authorization.Name, err)
+		}
+
+		err = tx.Delete(authorization).Error
+		if err != nil {
+			return nil, fmt.Errorf("remove stale authorization '%s': %w", authorization.Name, err)
+		}
+		diff.RemovedAuthorizations = append(diff.RemovedAuthorizations, authorization.Name)
+	}
+
+	for _, name := range authorizations {
+		if _, exists := ids[string(name)]; exists {
+			continue
+		}
+
+		authorization := &dbmodels.Authorization{Name: string(name)}
+		err = tx.Create(authorization).Error
+		if err != nil {
+			return nil, fmt.Errorf("create authorization '%s': %w", name, err)
+		}
+		ids[authorization.Name] = *authorization.ID
+		diff.AddedAuthorizations = append(diff.AddedAuthorizations, authorization.Name)
+	}
+
+	return ids, nil
+}
+
+// syncRoles Create missing roles, and optionally remove stale ones along with all bindings to them. Returns a map of
+// role names to IDs for all roles in the catalogue.
+func syncRoles(tx *gorm.DB, catalogue map[roles.Role][]roles.Authorization, removeStale bool, diff *RoleDiff) (map[string]uuid.UUID, error) {
+	existing := make([]*dbmodels.Role, 0)
+	err := tx.Find(&existing).Error
+	if err != nil {
+		return nil, fmt.Errorf("list roles: %w", err)
+	}
+
+	ids := make(map[string]uuid.UUID)
+	for _, role := range existing {
+		if _, known := catalogue[roles.Role(role.Name)]; known {
+			ids[role.Name] = *role.ID
+			continue
+		}
+
+		if !removeStale {
+			continue
+		}
+
+		err = tx.Where("role_id = ?", role.ID).Delete(&dbmodels.RoleAuthorization{}).Error
+		if err != nil {
+			return nil, fmt.Errorf("revoke authorizations from stale role '%s': %w", role.Name, err)
+		}
+
+		err = tx.Where("role_id = ?", role.ID).Delete(&dbmodels.UserRole{}).Error
+		if err != nil {
+			return nil, fmt.Errorf("remove bindings to stale role '%s': %w", role.Name, err)
+		}
+
+		err = tx.Delete(role).Error
+		if err != nil {
+			return nil, fmt.Errorf("remove stale role '%s': %w", role.Name, err)
+		}
+		diff.RemovedRoles = append(diff.RemovedRoles, role.Name)
+	}
+
+	for _, name := range sortedRoles(catalogue) {
+		if _, exists := ids[string(name)]; exists {
+			continue
+		}
+
+		role := &dbmodels.Role{Name: string(name)}
+		err = tx.Create(role).Error
+		if err != nil {
+			return nil, fmt.Errorf("create role '%s': %w", name, err)
+		}
+		ids[role.Name] = *role.ID
+		diff.AddedRoles = append(diff.AddedRoles, role.Name)
+	}
+
+	return ids, nil
+}
+
+func hasAuthorization(authorizations []roles.Authorization, name string) bool {
+	for _, authorization := range authorizations {
+		if string(authorization) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedRoles Return the role names of the catalogue in a stable order
+func sortedRoles(catalogue map[roles.Role][]roles.Authorization) []roles.Role {
+	names := make([]roles.Role, 0, len(catalogue))
+	for name := range catalogue {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i] < names[j]
+	})
+	return names
+}
@@ -0,0 +1,145 @@
+package fixtures_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/fixtures"
+	"github.com/nais/console/pkg/roles"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func setupRolesDB() *gorm.DB {
+	db := test.GetTestDB()
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Authorization{}, &dbmodels.Role{}, &dbmodels.RoleAuthorization{}, &dbmodels.UserRole{})
+	return db
+}
+
+// grants Get all role => authorization mappings in the database, keyed by role name
+func grants(t *testing.T, db *gorm.DB) map[string][]string {
+	rows := make([]*dbmodels.RoleAuthorization, 0)
+	assert.NoError(t, db.Preload("Role").Preload("Authorization").Find(&rows).Error)
+
+	result := make(map[string][]string)
+	for _, row := range rows {
+		result[row.Role.Name] = append(result[row.Role.Name], row.Authorization.Name)
+	}
+	return result
+}
+
+func createRole(t *testing.T, db *gorm.DB, name string, authorizations ...string) *dbmodels.Role {
+	role := &dbmodels.Role{Name: name}
+	assert.NoError(t, db.Create(role).Error)
+	for _, name := range authorizations {
+		authorization := &dbmodels.Authorization{}
+		assert.NoError(t, db.Where(dbmodels.Authorization{Name: name}).FirstOrCreate(authorization).Error)
+		assert.NoError(t, db.Create(&dbmodels.RoleAuthorization{RoleID: *role.ID, AuthorizationID: *authorization.ID}).Error)
+	}
+	return role
+}
+
+func assertCatalogue(t *testing.T, db *gorm.DB) {
+	actual := grants(t, db)
+	for role, authorizations := range roles.Roles {
+		expected := make([]string, 0, len(authorizations))
+		for _, authorization := range authorizations {
+			expected = append(expected, string(authorization))
+		}
+		assert.ElementsMatch(t, expected, actual[string(role)], "authorizations for role %s", role)
+	}
+}
+
+func TestSyncRolesAndAuthorizations(t *testing.T) {
+	t.Run("empty database", func(t *testing.T) {
+		db := setupRolesDB()
+
+		diff, err := fixtures.SyncRolesAndAuthorizations(db, false)
+		assert.NoError(t, err)
+		assert.Len(t, diff.AddedAuthorizations, len(roles.Authorizations))
+		assert.Len(t, diff.AddedRoles, len(roles.Roles))
+		assert.Empty(t, diff.Revoked)
+		assertCatalogue(t, db)
+	})
+
+	t.Run("second run makes no changes", func(t *testing.T) {
+		db := setupRolesDB()
+
+		_, err := fixtures.SyncRolesAndAuthorizations(db, false)
+		assert.NoError(t, err)
+
+		diff, err := fixtures.SyncRolesAndAuthorizations(db, false)
+		assert.NoError(t, err)
+		assert.True(t, diff.Empty())
+		assertCatalogue(t, db)
+	})
+
+	t.Run("existing database is upgraded", func(t *testing.T) {
+		db := setupRolesDB()
+
+		// Team member is missing audit_logs:read, and has been granted teams:delete by mistake. User viewer is missing.
+		createRole(t, db, string(roles.RoleTeamMember), string(roles.AuthorizationTeamsRead), string(roles.AuthorizationTeamsDelete))
+
+		diff, err := fixtures.SyncRolesAndAuthorizations(db, false)
+		assert.NoError(t, err)
+		assert.NotContains(t, diff.AddedRoles, string(roles.RoleTeamMember))
+		assert.Contains(t, diff.AddedRoles, string(roles.RoleUserViewer))
+		assert.NotContains(t, diff.AddedAuthorizations, string(roles.AuthorizationTeamsRead))
+		assert.Contains(t, diff.Granted, fixtures.RoleGrant{Role: string(roles.RoleTeamMember), Authorization: string(roles.AuthorizationAuditLogsRead)})
+		assert.Equal(t, []fixtures.RoleGrant{{Role: string(roles.RoleTeamMember), Authorization: string(roles.AuthorizationTeamsDelete)}}, diff.Revoked)
+		assertCatalogue(t, db)
+	})
+
+	t.Run("stale roles and authorizations are kept by default", func(t *testing.T) {
+		db := setupRolesDB()
+		createRole(t, db, "Legacy role", "legacy:read")
+
+		diff, err := fixtures.SyncRolesAndAuthorizations(db, false)
+		assert.NoError(t, err)
+		assert.Empty(t, diff.RemovedRoles)
+		assert.Empty(t, diff.RemovedAuthorizations)
+		assert.Equal(t, []string{"legacy:read"}, grants(t, db)["Legacy role"])
+		assertCatalogue(t, db)
+	})
+
+	t.Run("stale roles and authorizations are removed when requested", func(t *testing.T) {
+		db := setupRolesDB()
+		role := createRole(t, db, "Legacy role", "legacy:read")
+		user := &dbmodels.User{Email: "user@example.com"}
+		assert.NoError(t, db.Create(user).Error)
+		assert.NoError(t, db.Create(&dbmodels.UserRole{RoleID: *role.ID, UserID: *user.ID}).Error)
+
+		diff, err := fixtures.SyncRolesAndAuthorizations(db, true)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Legacy role"}, diff.RemovedRoles)
+		assert.Equal(t, []string{"legacy:read"}, diff.RemovedAuthorizations)
+
+		var count int64
+		db.Model(&dbmodels.Role{}).Where("name = ?", "Legacy role").Count(&count)
+		assert.Equal(t, int64(0), count)
+		db.Model(&dbmodels.Authorization{}).Where("name = ?", "legacy:read").Count(&count)
+		assert.Equal(t, int64(0), count)
+		db.Model(&dbmodels.UserRole{}).Where("user_id = ?", user.ID).Count(&count)
+		assert.Equal(t, int64(0), count)
+		assertCatalogue(t, db)
+	})
+
+	t.Run("roles in the catalogue are never removed", func(t *testing.T) {
+		db := setupRolesDB()
+		_, err := fixtures.SyncRolesAndAuthorizations(db, true)
+		assert.NoError(t, err)
+
+		admin := &dbmodels.Role{}
+		assert.NoError(t, db.Where("name = ?", roles.RoleAdmin).First(admin).Error)
+		id := *admin.ID
+
+		diff, err := fixtures.SyncRolesAndAuthorizations(db, true)
+		assert.NoError(t, err)
+		assert.True(t, diff.Empty())
+		assert.NoError(t, db.Where("name = ?", roles.RoleAdmin).First(admin).Error)
+		assert.Equal(t, id, *admin.ID)
+		assert.NotEqual(t, uuid.Nil, id)
+	})
+}
@@ -33,18 +33,30 @@ func CreateReconcilerSystems(db *gorm.DB) (map[string]*dbmodels.System, error) {
 
 	recs := registry.Reconcilers()
 	systems := make(map[string]*dbmodels.System)
-	for _, rec := range recs {
-		system := &dbmodels.System{
-			Name: rec.Name,
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := lock(tx)
+		if err != nil {
+			return err
 		}
 
-		log.Infof("Ensure system '%s' exists in the database...", rec.Name)
-		err := db.Where("name = ?", rec.Name).FirstOrCreate(system).Error
-		if err != nil {
-			return nil, err
+		for _, rec := range recs {
+			system := &dbmodels.System{
+				Name: rec.Name,
+			}
+
+			log.Infof("Ensure system '%s' exists in the database...", rec.Name)
+			err := tx.Where("name = ?", rec.Name).FirstOrCreate(system).Error
+			if err != nil {
+				return err
+			}
+
+			systems[rec.Name] = system
 		}
 
-		systems[rec.Name] = system
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	log.Infof("All systems have been added to the database.")
@@ -5,35 +5,14 @@ package graph
 
 import (
 	"context"
-	"crypto/rand"
-	"encoding/base64"
 
 	"github.com/google/uuid"
 	"github.com/nais/console/pkg/dbmodels"
 	"github.com/nais/console/pkg/graph/model"
-	"gorm.io/gorm"
 )
 
 func (r *mutationResolver) CreateAPIKey(ctx context.Context, userID *uuid.UUID) (*model.APIKey, error) {
-	buf := make([]byte, 16)
-	_, err := rand.Read(buf)
-	if err != nil {
-		return nil, err
-	}
-
-	key := &dbmodels.ApiKey{
-		APIKey: base64.RawURLEncoding.EncodeToString(buf),
-		UserID: *userID,
-	}
-	err = r.db.Transaction(func(tx *gorm.DB) error {
-		// FIXME: Handle deleted_by_id tracking
-		err = tx.Where("user_id = ?", key.UserID).Delete(&dbmodels.ApiKey{}).Error
-		if err != nil {
-			return err
-		}
-		return tx.Create(key).Error
-	})
-
+	key, err := dbmodels.CreateAPIKey(r.db, *userID)
 	if err != nil {
 		return nil, err
 	}
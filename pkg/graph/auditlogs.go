@@ -4,68 +4,148 @@ package graph
 // will be copied through when generating and any unknown code will be moved to the end.
 
 import (
+	"bytes"
 	"context"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgtype"
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/authz"
 	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/events"
 	"github.com/nais/console/pkg/graph/generated"
 	"github.com/nais/console/pkg/graph/model"
+	"github.com/nais/console/pkg/roles"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 )
 
 func (r *auditLogResolver) TargetSystem(ctx context.Context, obj *dbmodels.AuditLog) (*dbmodels.System, error) {
-	var system *dbmodels.System
-	err := r.db.Model(&obj).Association("TargetSystem").Find(&system)
-	if err != nil {
-		return nil, err
-	}
-	return system, nil
+	return r.loaders(ctx).Systems.Load(ctx, obj.TargetSystemID)
 }
 
 func (r *auditLogResolver) Correlation(ctx context.Context, obj *dbmodels.AuditLog) (*dbmodels.Correlation, error) {
-	var corr *dbmodels.Correlation
-	err := r.db.Model(&obj).Association("Correlation").Find(&corr)
-	if err != nil {
-		return nil, err
-	}
-	return corr, nil
+	return r.loaders(ctx).Correlations.Load(ctx, obj.CorrelationID)
 }
 
 func (r *auditLogResolver) Actor(ctx context.Context, obj *dbmodels.AuditLog) (*dbmodels.User, error) {
 	if obj.ActorID == nil {
 		return nil, nil
 	}
-	var actor *dbmodels.User
-	err := r.db.Model(&obj).Association("Actor").Find(&actor)
-	if err != nil {
-		return nil, err
-	}
-	return actor, nil
+	return r.loaders(ctx).Users.Load(ctx, *obj.ActorID)
 }
 
 func (r *auditLogResolver) TargetUser(ctx context.Context, obj *dbmodels.AuditLog) (*dbmodels.User, error) {
 	if obj.TargetUserID == nil {
 		return nil, nil
 	}
-	var targetUser *dbmodels.User
-	err := r.db.Model(&obj).Association("TargetUser").Find(&targetUser)
+	return r.loaders(ctx).Users.Load(ctx, *obj.TargetUserID)
+}
+
+func (r *auditLogResolver) TargetTeam(ctx context.Context, obj *dbmodels.AuditLog) (*dbmodels.Team, error) {
+	if obj.TargetTeamID == nil {
+		return nil, nil
+	}
+	return r.loaders(ctx).Teams.Load(ctx, *obj.TargetTeamID)
+}
+
+func (r *auditLogResolver) Details(ctx context.Context, obj *dbmodels.AuditLog) (map[string]interface{}, error) {
+	if obj.Details.Status != pgtype.Present {
+		return nil, nil
+	}
+
+	details := make(map[string]interface{})
+	err := obj.Details.AssignTo(&details)
 	if err != nil {
 		return nil, err
 	}
-	return targetUser, nil
+
+	return details, nil
 }
 
-func (r *auditLogResolver) TargetTeam(ctx context.Context, obj *dbmodels.AuditLog) (*dbmodels.Team, error) {
-	if obj.TargetTeamID == nil {
+func (r *correlationResolver) Actor(ctx context.Context, obj *dbmodels.Correlation) (*dbmodels.User, error) {
+	if obj.CreatedByID == nil {
 		return nil, nil
 	}
-	var team *dbmodels.Team
-	err := r.db.Model(&obj).Association("TargetTeam").Find(&team)
+	return r.loaders(ctx).Users.Load(ctx, *obj.CreatedByID)
+}
+
+func (r *correlationResolver) StartedAt(ctx context.Context, obj *dbmodels.Correlation) (*time.Time, error) {
+	return &obj.CreatedAt, nil
+}
+
+func (r *correlationResolver) FinishedAt(ctx context.Context, obj *dbmodels.Correlation) (*time.Time, error) {
+	latestOf := func(order string) *gorm.DB {
+		return r.db.WithContext(ctx).Where("correlation_id = ?", obj.ID).Order(order).Limit(1)
+	}
+
+	auditLogs := make([]*dbmodels.AuditLog, 0)
+	err := latestOf("sequence DESC").Find(&auditLogs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	reconcileErrors := make([]*dbmodels.ReconcileError, 0)
+	err = latestOf("created_at DESC").Find(&reconcileErrors).Error
+	if err != nil {
+		return nil, err
+	}
+
+	reconcileResults := make([]*dbmodels.ReconcileResult, 0)
+	err = latestOf("updated_at DESC").Find(&reconcileResults).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var finishedAt *time.Time
+	latest := func(t time.Time) {
+		if finishedAt == nil || t.After(*finishedAt) {
+			finishedAt = &t
+		}
+	}
+	for _, entry := range auditLogs {
+		latest(entry.CreatedAt)
+	}
+	for _, reconcileError := range reconcileErrors {
+		latest(reconcileError.CreatedAt)
+	}
+	for _, result := range reconcileResults {
+		latest(result.UpdatedAt)
+	}
+
+	return finishedAt, nil
+}
+
+func (r *correlationResolver) AuditLogs(ctx context.Context, obj *dbmodels.Correlation) ([]*dbmodels.AuditLog, error) {
+	auditLogs := make([]*dbmodels.AuditLog, 0)
+	err := r.db.WithContext(ctx).Where("correlation_id = ?", obj.ID).Order("sequence ASC").Find(&auditLogs).Error
+	if err != nil {
+		return nil, err
+	}
+	return auditLogs, nil
+}
+
+func (r *correlationResolver) ReconcileErrors(ctx context.Context, obj *dbmodels.Correlation) ([]*dbmodels.ReconcileError, error) {
+	reconcileErrors := make([]*dbmodels.ReconcileError, 0)
+	err := r.db.WithContext(ctx).Preload("System").Preload("Team").Where("correlation_id = ?", obj.ID).Order("created_at ASC").Find(&reconcileErrors).Error
+	if err != nil {
+		return nil, err
+	}
+	return reconcileErrors, nil
+}
+
+func (r *correlationResolver) ReconcileResults(ctx context.Context, obj *dbmodels.Correlation) ([]*dbmodels.ReconcileResult, error) {
+	reconcileResults := make([]*dbmodels.ReconcileResult, 0)
+	err := r.db.WithContext(ctx).Preload("System").Preload("Team").Where("correlation_id = ?", obj.ID).Order("created_at ASC").Find(&reconcileResults).Error
 	if err != nil {
 		return nil, err
 	}
-	return team, nil
+	return reconcileResults, nil
 }
 
-func (r *queryResolver) AuditLogs(ctx context.Context, pagination *model.Pagination, query *model.AuditLogsQuery, sort *model.AuditLogsSort) (*model.AuditLogs, error) {
+func (r *queryResolver) AuditLogs(ctx context.Context, pagination *model.Pagination, first *int, after *string, query *model.AuditLogsQuery, sort *model.AuditLogsSort) (*model.AuditLogs, error) {
 	auditLogs := make([]*dbmodels.AuditLog, 0)
 
 	if sort == nil {
@@ -74,14 +154,133 @@ func (r *queryResolver) AuditLogs(ctx context.Context, pagination *model.Paginat
 			Direction: model.SortDirectionDesc,
 		}
 	}
-	pageInfo, err := r.paginatedQuery(pagination, query, sort, &dbmodels.AuditLog{}, &auditLogs)
+	pageInfo, cursors, err := r.paginatedQuery(ctx, pagination, first, after, query, sort, &dbmodels.AuditLog{}, &auditLogs)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*model.AuditLogEdge, len(auditLogs))
+	for i := range auditLogs {
+		edges[i] = &model.AuditLogEdge{
+			Cursor: cursors[i],
+			Node:   auditLogs[i],
+		}
+	}
+
 	return &model.AuditLogs{
 		PageInfo: pageInfo,
 		Nodes:    auditLogs,
-	}, err
+		Edges:    edges,
+	}, nil
+}
+
+func (r *queryResolver) ExportAuditLogs(ctx context.Context, format model.AuditLogExportFormat, createdAfter *time.Time, createdBefore *time.Time) (string, error) {
+	err := authz.RequireGlobalAuthorization(authz.UserFromContext(ctx), roles.AuthorizationAuditLogsRead)
+	if err != nil {
+		return "", err
+	}
+
+	exportFormat, err := auditlogger.ParseExportFormat(format.String())
+	if err != nil {
+		return "", err
+	}
+
+	buf := &bytes.Buffer{}
+	err = auditlogger.Export(r.db.WithContext(ctx), buf, exportFormat, createdAfter, createdBefore)
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func (r *queryResolver) VerifyAuditLogChain(ctx context.Context) (*model.AuditLogChainVerification, error) {
+	err := authz.RequireGlobalAuthorization(authz.UserFromContext(ctx), roles.AuthorizationAuditLogsRead)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := auditlogger.VerifyChain(r.db.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	problems := make([]*model.AuditLogChainProblem, len(result.Problems))
+	for i, problem := range result.Problems {
+		auditLogID := problem.AuditLogID
+		problems[i] = &model.AuditLogChainProblem{
+			Kind:       model.AuditLogChainProblemKind(strings.ToUpper(string(problem.Kind))),
+			Sequence:   int(problem.Sequence),
+			AuditLogID: &auditLogID,
+			Message:    problem.Message,
+		}
+	}
+
+	return &model.AuditLogChainVerification{
+		Valid:         result.Valid(),
+		Entries:       result.Entries,
+		FirstSequence: int(result.FirstSequence),
+		LastSequence:  int(result.LastSequence),
+		Problems:      problems,
+	}, nil
+}
+
+func (r *queryResolver) Correlation(ctx context.Context, id *uuid.UUID) (*dbmodels.Correlation, error) {
+	corr := &dbmodels.Correlation{}
+	err := r.db.Where("id = ?", id).First(corr).Error
+	if err != nil {
+		return nil, err
+	}
+	return corr, nil
+}
+
+func (r *subscriptionResolver) AuditLogCreated(ctx context.Context, teamID *uuid.UUID) (<-chan *dbmodels.AuditLog, error) {
+	team := &dbmodels.Team{}
+	err := r.db.Where("id = ?", teamID).First(team).Error
+	if err != nil {
+		return nil, err
+	}
+
+	teamEvents, unsubscribe := r.events.Subscribe(*team.ID)
+	ch := make(chan *dbmodels.AuditLog)
+
+	go func() {
+		defer close(ch)
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-teamEvents:
+				if event.Type != events.TypeAuditLogCreated || event.AuditLogID == nil {
+					continue
+				}
+
+				auditLog := &dbmodels.AuditLog{}
+				err := r.db.Where("id = ?", event.AuditLogID).First(auditLog).Error
+				if err != nil {
+					log.Warnf("unable to send audit log event: %s", err)
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- auditLog:
+				}
+			}
+		}
+	}()
+
+	return ch, nil
 }
 
 // AuditLog returns generated.AuditLogResolver implementation.
 func (r *Resolver) AuditLog() generated.AuditLogResolver { return &auditLogResolver{r} }
 
+// Correlation returns generated.CorrelationResolver implementation.
+func (r *Resolver) Correlation() generated.CorrelationResolver { return &correlationResolver{r} }
+
 type auditLogResolver struct{ *Resolver }
+type correlationResolver struct{ *Resolver }
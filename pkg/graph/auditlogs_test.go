@@ -0,0 +1,232 @@
+package graph_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/authz"
+	"github.com/nais/console/pkg/dataloader"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/directives"
+	"github.com/nais/console/pkg/graph"
+	"github.com/nais/console/pkg/graph/generated"
+	"github.com/nais/console/pkg/reconcilers"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestQueryResolver_AuditLogsQueryCount(t *testing.T) {
+	const numAuditLogs = 50
+
+	db := test.GetTestDB()
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Team{}, &dbmodels.UserTeam{}, &dbmodels.ApiKey{}, &dbmodels.System{}, &dbmodels.Correlation{}, &dbmodels.AuditLog{})
+
+	system := &dbmodels.System{Name: "system"}
+	db.Create(system)
+
+	users := make([]*dbmodels.User, 0)
+	teams := make([]*dbmodels.Team, 0)
+	for i := 0; i < 10; i++ {
+		user := &dbmodels.User{Email: fmt.Sprintf("user%d@example.com", i), Name: fmt.Sprintf("User %d", i)}
+		db.Create(user)
+		users = append(users, user)
+
+		team := &dbmodels.Team{Slug: dbmodels.Slug(fmt.Sprintf("team-%c", 'a'+i)), Name: fmt.Sprintf("Team %d", i)}
+		db.Create(team)
+		teams = append(teams, team)
+
+		db.Create(&dbmodels.UserTeam{UserID: *user.ID, TeamID: *team.ID})
+	}
+	db.Create(&dbmodels.ApiKey{APIKey: "key", UserID: *users[0].ID})
+
+	for i := 0; i < numAuditLogs; i++ {
+		corr := &dbmodels.Correlation{}
+		db.Create(corr)
+		db.Create(&dbmodels.AuditLog{
+			ActorID:        users[i%len(users)].ID,
+			CorrelationID:  *corr.ID,
+			TargetSystemID: *system.ID,
+			TargetTeamID:   teams[i%len(teams)].ID,
+			TargetUserID:   users[(i+1)%len(users)].ID,
+			Action:         "action",
+			Message:        "message",
+			Sequence:       int64(i + 1),
+		})
+	}
+
+	var queries int64
+	db.Callback().Query().After("gorm:query").Register("test:count_queries", func(*gorm.DB) {
+		atomic.AddInt64(&queries, 1)
+	})
+
+	gc := generated.Config{
+		Resolvers: graph.NewResolver(db, "example.com", system, make(chan reconcilers.Input), nil, nil, nil),
+	}
+	gc.Directives.Auth = directives.Auth(db)
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(gc))
+	c := client.New(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := authz.ContextWithUser(r.Context(), &dbmodels.User{Model: dbmodels.Model{ID: users[0].ID}})
+		ctx = dataloader.ContextWithLoaders(ctx, dataloader.NewLoaders(ctx, db))
+		srv.ServeHTTP(w, r.WithContext(ctx))
+	}))
+
+	var resp struct {
+		AuditLogs struct {
+			Nodes []struct {
+				Actor struct {
+					Email     string
+					HasAPIKey bool
+					Teams     []struct{ Slug string }
+				}
+				TargetTeam struct {
+					Slug  string
+					Users []struct{ Email string }
+				}
+				TargetSystem struct{ Name string }
+				TargetUser   struct{ Email string }
+				Correlation  struct{ ID string }
+			}
+		}
+	}
+
+	atomic.StoreInt64(&queries, 0)
+	err := c.Post(`{
+		auditLogs(pagination: {offset: 0, limit: 100}) {
+			nodes {
+				actor { email hasAPIKey teams { slug } }
+				targetTeam { slug users { email } }
+				targetSystem { name }
+				targetUser { email }
+				correlation { id }
+			}
+		}
+	}`, &resp)
+	assert.NoError(t, err)
+
+	assert.Len(t, resp.AuditLogs.Nodes, numAuditLogs)
+	for _, node := range resp.AuditLogs.Nodes {
+		assert.NotEmpty(t, node.Actor.Email)
+		assert.Len(t, node.Actor.Teams, 1)
+		assert.Len(t, node.TargetTeam.Users, 1)
+		assert.Equal(t, "system", node.TargetSystem.Name)
+		assert.NotEmpty(t, node.TargetUser.Email)
+		assert.NotEmpty(t, node.Correlation.ID)
+	}
+
+	// One query for the authenticated user, two for the audit logs (count and select), and at most two for each of the
+	// loaders. Without batching this would be several queries per audit log entry.
+	assert.LessOrEqual(t, atomic.LoadInt64(&queries), int64(3+2*7))
+}
+
+func TestQueryResolver_Correlation(t *testing.T) {
+	db := test.GetTestDB()
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Team{}, &dbmodels.UserTeam{}, &dbmodels.ApiKey{}, &dbmodels.System{}, &dbmodels.Correlation{}, &dbmodels.AuditLog{}, &dbmodels.ReconcileError{}, &dbmodels.ReconcileResult{})
+
+	user := &dbmodels.User{Email: "user@example.com", Name: "User"}
+	team := &dbmodels.Team{Slug: "team", Name: "Team"}
+	console := &dbmodels.System{Name: "console"}
+	github := &dbmodels.System{Name: "github:team"}
+	google := &dbmodels.System{Name: "google:gcp:project"}
+	db.Create(user)
+	db.Create(team)
+	db.Create(console)
+	db.Create(github)
+	db.Create(google)
+
+	corr := &dbmodels.Correlation{
+		Model:     dbmodels.Model{CreatedByID: user.ID},
+		Trigger:   dbmodels.CorrelationTriggerMutation,
+		Operation: "addUsersToTeam",
+	}
+	db.Create(corr)
+
+	logger := auditlogger.New(db)
+	assert.NoError(t, logger.Logf("github:team:add-member", *corr, *github, nil, team, user, "added member"))
+	assert.NoError(t, dbmodels.SetReconcileResult(db, *corr.ID, *github.ID, *team.ID, nil))
+	reconcileErr := errors.New("quota exceeded")
+	db.Create(&dbmodels.ReconcileError{CorrelationID: *corr.ID, SystemID: *google.ID, TeamID: *team.ID, Message: reconcileErr.Error()})
+	assert.NoError(t, dbmodels.SetReconcileResult(db, *corr.ID, *google.ID, *team.ID, reconcileErr))
+
+	gc := generated.Config{
+		Resolvers: graph.NewResolver(db, "example.com", console, make(chan reconcilers.Input), nil, nil, nil),
+	}
+	gc.Directives.Auth = directives.Auth(db)
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(gc))
+	c := client.New(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := authz.ContextWithUser(r.Context(), user)
+		srv.ServeHTTP(w, r.WithContext(ctx))
+	}))
+
+	var resp struct {
+		Correlation struct {
+			Trigger    string
+			Operation  string
+			Actor      struct{ Email string }
+			StartedAt  string
+			FinishedAt *string
+			AuditLogs  []struct {
+				Action string
+			}
+			ReconcileErrors []struct {
+				System  struct{ Name string }
+				Message string
+			}
+			ReconcileResults []struct {
+				System  struct{ Name string }
+				Team    struct{ Slug string }
+				Success bool
+				Message string
+			}
+		}
+	}
+
+	err := c.Post(`query($id: UUID!) {
+		correlation(id: $id) {
+			trigger
+			operation
+			actor { email }
+			startedAt
+			finishedAt
+			auditLogs { action }
+			reconcileErrors { system { name } message }
+			reconcileResults { system { name } team { slug } success message }
+		}
+	}`, &resp, client.Var("id", corr.ID.String()))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "MUTATION", resp.Correlation.Trigger)
+	assert.Equal(t, "addUsersToTeam", resp.Correlation.Operation)
+	assert.Equal(t, "user@example.com", resp.Correlation.Actor.Email)
+	assert.NotEmpty(t, resp.Correlation.StartedAt)
+	assert.NotNil(t, resp.Correlation.FinishedAt)
+
+	assert.Len(t, resp.Correlation.AuditLogs, 1)
+	assert.Equal(t, "github:team:add-member", resp.Correlation.AuditLogs[0].Action)
+
+	assert.Len(t, resp.Correlation.ReconcileErrors, 1)
+	assert.Equal(t, "google:gcp:project", resp.Correlation.ReconcileErrors[0].System.Name)
+	assert.Equal(t, "quota exceeded", resp.Correlation.ReconcileErrors[0].Message)
+
+	assert.Len(t, resp.Correlation.ReconcileResults, 2)
+	for _, result := range resp.Correlation.ReconcileResults {
+		assert.Equal(t, "team", result.Team.Slug)
+		if result.System.Name == "github:team" {
+			assert.True(t, result.Success)
+			assert.Empty(t, result.Message)
+		} else {
+			assert.False(t, result.Success)
+			assert.Equal(t, "quota exceeded", result.Message)
+		}
+	}
+}
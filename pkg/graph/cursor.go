@@ -0,0 +1,135 @@
+package graph
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/graph/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// defaultPageSize Number of entries returned by collection queries when no page size has been requested
+const defaultPageSize = 50
+
+// maxPageSize Largest number of entries that can be requested in a single page when using cursor pagination
+const maxPageSize = 100
+
+// cursor Position of an entry in a sorted collection. The ID of the entry is included to make the position unique when
+// several entries share the same value in the sort column.
+type cursor struct {
+	Value json.RawMessage `json:"v"`
+	ID    uuid.UUID       `json:"id"`
+}
+
+// sortKey The columns that make up the stable sort order of a collection
+type sortKey struct {
+	sort      model.QueryOrder
+	field     *schema.Field
+	id        *schema.Field
+	direction model.SortDirection
+}
+
+func newSortKey(db *gorm.DB, dbModel interface{}, sort model.QueryOrder) (*sortKey, error) {
+	stmt := &gorm.Statement{DB: db}
+	err := stmt.Parse(dbModel)
+	if err != nil {
+		return nil, err
+	}
+
+	field := stmt.Schema.LookUpField(sort.GetSortField())
+	if field == nil {
+		return nil, fmt.Errorf("unable to sort by unknown field '%s'", sort.GetSortField())
+	}
+
+	return &sortKey{
+		sort:      sort,
+		field:     field,
+		id:        stmt.Schema.PrioritizedPrimaryField,
+		direction: sort.GetSortDirection(),
+	}, nil
+}
+
+// order Sort a query by the sort column, then by ID
+func (k *sortKey) order(db *gorm.DB) *gorm.DB {
+	return db.Order(k.sort.GetOrderString()).Order(k.id.DBName + " " + string(k.direction))
+}
+
+// after Limit a query to entries following the entry at the cursor
+func (k *sortKey) after(db *gorm.DB, encoded string) (*gorm.DB, error) {
+	value, id, err := k.decode(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	op := ">"
+	if k.direction == model.SortDirectionDesc {
+		op = "<"
+	}
+
+	condition := fmt.Sprintf("%[1]s %[3]s ? OR (%[1]s = ? AND %[2]s %[3]s ?)", k.field.DBName, k.id.DBName, op)
+	return db.Where(condition, value, value, id), nil
+}
+
+// cursors Get the cursor of each entry in a collection
+func (k *sortKey) cursors(ctx context.Context, collection interface{}) ([]string, error) {
+	rows := reflect.Indirect(reflect.ValueOf(collection))
+	cursors := make([]string, rows.Len())
+
+	for i := range cursors {
+		row := rows.Index(i)
+		value, _ := k.field.ValueOf(ctx, row)
+		id, _ := k.id.ValueOf(ctx, row)
+
+		encodedValue, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		c := cursor{Value: encodedValue}
+		switch id := id.(type) {
+		case *uuid.UUID:
+			c.ID = *id
+		case uuid.UUID:
+			c.ID = id
+		default:
+			return nil, fmt.Errorf("unable to create cursor for entry with ID of type %T", id)
+		}
+
+		encoded, err := json.Marshal(c)
+		if err != nil {
+			return nil, err
+		}
+		cursors[i] = base64.RawURLEncoding.EncodeToString(encoded)
+	}
+
+	return cursors, nil
+}
+
+// decode Get the sort column value and ID of the entry at the cursor
+func (k *sortKey) decode(encoded string) (interface{}, uuid.UUID, error) {
+	invalid := fmt.Errorf("invalid cursor")
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, uuid.Nil, invalid
+	}
+
+	c := cursor{}
+	err = json.Unmarshal(data, &c)
+	if err != nil {
+		return nil, uuid.Nil, invalid
+	}
+
+	value := reflect.New(k.field.FieldType)
+	err = json.Unmarshal(c.Value, value.Interface())
+	if err != nil {
+		return nil, uuid.Nil, invalid
+	}
+
+	return value.Elem().Interface(), c.ID, nil
+}
@@ -0,0 +1,58 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/authz"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/graph/generated"
+	"github.com/nais/console/pkg/roles"
+)
+
+func (r *driftFindingResolver) FirstDetectedAt(ctx context.Context, obj *dbmodels.DriftFinding) (*time.Time, error) {
+	return &obj.CreatedAt, nil
+}
+
+func (r *driftFindingResolver) LastDetectedAt(ctx context.Context, obj *dbmodels.DriftFinding) (*time.Time, error) {
+	return &obj.UpdatedAt, nil
+}
+
+func (r *queryResolver) DriftFindings(ctx context.Context, teamID *uuid.UUID, systemID *uuid.UUID) ([]*dbmodels.DriftFinding, error) {
+	user := authz.UserFromContext(ctx)
+	query := r.db.WithContext(ctx).Preload("System").Preload("Team")
+
+	if teamID != nil {
+		err := authz.RequireTeamAuthorization(user, roles.AuthorizationTeamsRead, *teamID)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("team_id = ?", teamID)
+	} else {
+		err := authz.RequireGlobalAuthorization(user, roles.AuthorizationTeamsRead)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if systemID != nil {
+		query = query.Where("system_id = ?", systemID)
+	}
+
+	findings := make([]*dbmodels.DriftFinding, 0)
+	err := query.Order("created_at ASC").Find(&findings).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return findings, nil
+}
+
+// DriftFinding returns generated.DriftFindingResolver implementation.
+func (r *Resolver) DriftFinding() generated.DriftFindingResolver { return &driftFindingResolver{r} }
+
+type driftFindingResolver struct{ *Resolver }
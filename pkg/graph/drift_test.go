@@ -0,0 +1,76 @@
+package graph_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nais/console/pkg/authz"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/graph"
+	"github.com/nais/console/pkg/reconcilers"
+	"github.com/nais/console/pkg/roles"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryResolver_DriftFindings(t *testing.T) {
+	db := test.GetTestDB()
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Team{}, &dbmodels.System{}, &dbmodels.DriftFinding{})
+
+	github := &dbmodels.System{Name: "github:team"}
+	azure := &dbmodels.System{Name: "azure:group"}
+	team := &dbmodels.Team{Slug: "team", Name: "Team"}
+	otherTeam := &dbmodels.Team{Slug: "other-team", Name: "Other team"}
+	db.Create(github)
+	db.Create(azure)
+	db.Create(team)
+	db.Create(otherTeam)
+
+	db.Create(&dbmodels.DriftFinding{SystemID: *github.ID, TeamID: *team.ID, Kind: dbmodels.DriftKindExtraMember, Resource: "team", Subject: "intruder", Message: "extra"})
+	db.Create(&dbmodels.DriftFinding{SystemID: *azure.ID, TeamID: *team.ID, Kind: dbmodels.DriftKindRenamed, Resource: "nais-team-team", Subject: "renamed", Message: "renamed"})
+	db.Create(&dbmodels.DriftFinding{SystemID: *github.ID, TeamID: *otherTeam.ID, Kind: dbmodels.DriftKindMissingResource, Resource: "other-team", Message: "missing"})
+
+	resolver := graph.NewResolver(db, "example.com", getSystem(), make(chan reconcilers.Input), nil, nil, nil).Query()
+
+	teamReader := &dbmodels.User{RoleBindings: []dbmodels.UserRole{{
+		Role:     dbmodels.Role{Authorizations: []dbmodels.Authorization{{Name: string(roles.AuthorizationTeamsRead)}}},
+		TargetID: team.ID,
+	}}}
+	globalReader := &dbmodels.User{RoleBindings: []dbmodels.UserRole{{
+		Role: dbmodels.Role{Authorizations: []dbmodels.Authorization{{Name: string(roles.AuthorizationTeamsRead)}}},
+	}}}
+
+	t.Run("Team reader", func(t *testing.T) {
+		ctx := authz.ContextWithUser(context.Background(), teamReader)
+
+		findings, err := resolver.DriftFindings(ctx, team.ID, nil)
+		assert.NoError(t, err)
+		assert.Len(t, findings, 2)
+
+		findings, err = resolver.DriftFindings(ctx, team.ID, github.ID)
+		assert.NoError(t, err)
+		assert.Len(t, findings, 1)
+		assert.Equal(t, "intruder", findings[0].Subject)
+		assert.Equal(t, "github:team", findings[0].System.Name)
+
+		_, err = resolver.DriftFindings(ctx, otherTeam.ID, nil)
+		assert.ErrorIs(t, err, authz.ErrNotAuthorized)
+
+		_, err = resolver.DriftFindings(ctx, nil, nil)
+		assert.ErrorIs(t, err, authz.ErrNotAuthorized)
+	})
+
+	t.Run("Global reader", func(t *testing.T) {
+		ctx := authz.ContextWithUser(context.Background(), globalReader)
+
+		findings, err := resolver.DriftFindings(ctx, nil, nil)
+		assert.NoError(t, err)
+		assert.Len(t, findings, 3)
+
+		findings, err = resolver.DriftFindings(ctx, nil, github.ID)
+		assert.NoError(t, err)
+		assert.Len(t, findings, 2)
+	})
+}
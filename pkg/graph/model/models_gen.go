@@ -6,11 +6,17 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/nais/console/pkg/dbmodels"
 )
 
+// The result of a search, either a team or a user.
+type SearchResult interface {
+	IsSearchResult()
+}
+
 // API key type.
 type APIKey struct {
 	// The API key.
@@ -25,12 +31,48 @@ type AddUsersToTeamInput struct {
 	TeamID *uuid.UUID `json:"teamId"`
 }
 
+// A problem found when verifying the hash chain of the audit log.
+type AuditLogChainProblem struct {
+	// The kind of problem.
+	Kind AuditLogChainProblemKind `json:"kind"`
+	// Sequence number of the entry where the problem was found.
+	Sequence int `json:"sequence"`
+	// ID of the entry where the problem was found.
+	AuditLogID *uuid.UUID `json:"auditLogId"`
+	// Description of the problem.
+	Message string `json:"message"`
+}
+
+// Result of verifying the hash chain of the audit log.
+type AuditLogChainVerification struct {
+	// Whether or not the chain is intact.
+	Valid bool `json:"valid"`
+	// Number of entries in the chain.
+	Entries int `json:"entries"`
+	// Sequence number of the first entry in the chain. The link to the entry before it, if any, can not be verified.
+	FirstSequence int `json:"firstSequence"`
+	// Sequence number of the last entry in the chain.
+	LastSequence int `json:"lastSequence"`
+	// Problems found in the chain.
+	Problems []*AuditLogChainProblem `json:"problems"`
+}
+
+// An edge in a collection of audit log entries.
+type AuditLogEdge struct {
+	// Cursor of the audit log entry, to be used as the after argument when paginating.
+	Cursor string `json:"cursor"`
+	// The audit log entry.
+	Node *dbmodels.AuditLog `json:"node"`
+}
+
 // Audit log collection.
 type AuditLogs struct {
 	// Object related to pagination of the collection.
 	PageInfo *PageInfo `json:"pageInfo"`
 	// The list of audit log entries in the collection.
 	Nodes []*dbmodels.AuditLog `json:"nodes"`
+	// The list of edges in the collection, for cursor pagination.
+	Edges []*AuditLogEdge `json:"edges"`
 }
 
 // Input for filtering a collection of audit log entries.
@@ -45,6 +87,12 @@ type AuditLogsQuery struct {
 	TargetTeamID *uuid.UUID `json:"targetTeamId"`
 	// Filter by target user ID.
 	TargetUserID *uuid.UUID `json:"targetUserId"`
+	// Filter by action. End the action with * to match all actions starting with the given prefix, for instance github:team:*.
+	Action *string `json:"action"`
+	// Only include entries created at or after this time.
+	CreatedAfter *time.Time `json:"createdAfter"`
+	// Only include entries created before this time.
+	CreatedBefore *time.Time `json:"createdBefore"`
 }
 
 // Input for sorting a collection of audit log entries.
@@ -71,19 +119,11 @@ type CreateTeamInput struct {
 	Purpose *string `json:"purpose"`
 }
 
-// Pagination metadata attached to queries resulting in a collection of data.
-type PageInfo struct {
-	// Total number of results that matches the query.
-	Results int `json:"results"`
-	// Which record number the returned collection starts at.
-	Offset int `json:"offset"`
-	// Maximum number of records included in the collection.
-	Limit int `json:"limit"`
-}
-
 // When querying collections this input is used to control the offset and the page size of the returned slice.
 //
 // Please note that collections are not stateful, so data added or created in between your paginated requests might not be reflected in the returned result set.
+//
+// Offset pagination is deprecated. Use the first and after arguments instead, which return stable results.
 type Pagination struct {
 	// The offset to start fetching entries.
 	Offset int `json:"offset"`
@@ -99,12 +139,22 @@ type RemoveUsersFromTeamInput struct {
 	TeamID *uuid.UUID `json:"teamId"`
 }
 
+// An edge in a collection of systems.
+type SystemEdge struct {
+	// Cursor of the system, to be used as the after argument when paginating.
+	Cursor string `json:"cursor"`
+	// The system.
+	Node *dbmodels.System `json:"node"`
+}
+
 // System collection.
 type Systems struct {
 	// Object related to pagination of the collection.
 	PageInfo *PageInfo `json:"pageInfo"`
 	// The list of system objects in the collection.
 	Nodes []*dbmodels.System `json:"nodes"`
+	// The list of edges in the collection, for cursor pagination.
+	Edges []*SystemEdge `json:"edges"`
 }
 
 // Input for filtering a collection of systems.
@@ -121,12 +171,46 @@ type SystemsSort struct {
 	Direction SortDirection `json:"direction"`
 }
 
+// An edge in a collection of teams.
+type TeamEdge struct {
+	// Cursor of the team, to be used as the after argument when paginating.
+	Cursor string `json:"cursor"`
+	// The team.
+	Node *dbmodels.Team `json:"node"`
+}
+
+// A GitHub repository a team should have access to.
+type TeamRepositoryInput struct {
+	// Name of the repository in the GitHub organization, without the organization.
+	Name string `json:"name"`
+	// The permission the team should have on the repository.
+	Permission dbmodels.RepositoryPermission `json:"permission"`
+}
+
+// Team synchronization event.
+type TeamSyncEvent struct {
+	// The kind of event.
+	Type TeamSyncEventType `json:"type"`
+	// The team being synchronized.
+	Team *dbmodels.Team `json:"team"`
+	// The system the event relates to. When this field is empty the event relates to the synchronization as a whole.
+	System *dbmodels.System `json:"system"`
+	// The correlation of the synchronization.
+	Correlation *dbmodels.Correlation `json:"correlation"`
+	// Details about the event, for instance the error message when a system fails.
+	Message *string `json:"message"`
+	// Time of the event.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
 // Team collection.
 type Teams struct {
 	// Object related to pagination of the collection.
 	PageInfo *PageInfo `json:"pageInfo"`
 	// The list of team objects in the collection.
 	Nodes []*dbmodels.Team `json:"nodes"`
+	// The list of edges in the collection, for cursor pagination.
+	Edges []*TeamEdge `json:"edges"`
 }
 
 // Input for filtering a collection of teams.
@@ -135,6 +219,8 @@ type TeamsQuery struct {
 	Slug *dbmodels.Slug `json:"slug"`
 	// Filter by name.
 	Name *string `json:"name"`
+	// Case-insensitive search for teams where the slug, name or purpose contains the search term.
+	Search *string `json:"search"`
 }
 
 // Input for sorting a collection of teams.
@@ -151,12 +237,22 @@ type UpdateServiceAccountInput struct {
 	Name *dbmodels.Slug `json:"name"`
 }
 
+// An edge in a collection of users.
+type UserEdge struct {
+	// Cursor of the user, to be used as the after argument when paginating.
+	Cursor string `json:"cursor"`
+	// The user.
+	Node *dbmodels.User `json:"node"`
+}
+
 // User collection.
 type Users struct {
 	// Object related to pagination of the collection.
 	PageInfo *PageInfo `json:"pageInfo"`
 	// The list of user objects in the collection.
 	Nodes []*dbmodels.User `json:"nodes"`
+	// The list of edges in the collection, for cursor pagination.
+	Edges []*UserEdge `json:"edges"`
 }
 
 // Input for filtering a collection of users.
@@ -165,6 +261,8 @@ type UsersQuery struct {
 	Email *string `json:"email"`
 	// Filter by user name.
 	Name *string `json:"name"`
+	// Case-insensitive search for users where the name or email address contains the search term.
+	Search *string `json:"search"`
 }
 
 // Input for sorting a collection of users.
@@ -175,6 +273,97 @@ type UsersSort struct {
 	Direction SortDirection `json:"direction"`
 }
 
+// Kinds of problems found when verifying the hash chain of the audit log.
+type AuditLogChainProblemKind string
+
+const (
+	// One or more entries are missing from the chain.
+	AuditLogChainProblemKindGap AuditLogChainProblemKind = "GAP"
+	// The content of the entry does not match its hash.
+	AuditLogChainProblemKindModified AuditLogChainProblemKind = "MODIFIED"
+	// The previous hash of the entry does not match the hash of the entry before it.
+	AuditLogChainProblemKindBrokenLink AuditLogChainProblemKind = "BROKEN_LINK"
+)
+
+var AllAuditLogChainProblemKind = []AuditLogChainProblemKind{
+	AuditLogChainProblemKindGap,
+	AuditLogChainProblemKindModified,
+	AuditLogChainProblemKindBrokenLink,
+}
+
+func (e AuditLogChainProblemKind) IsValid() bool {
+	switch e {
+	case AuditLogChainProblemKindGap, AuditLogChainProblemKindModified, AuditLogChainProblemKindBrokenLink:
+		return true
+	}
+	return false
+}
+
+func (e AuditLogChainProblemKind) String() string {
+	return string(e)
+}
+
+func (e *AuditLogChainProblemKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditLogChainProblemKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditLogChainProblemKind", str)
+	}
+	return nil
+}
+
+func (e AuditLogChainProblemKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Formats available when exporting audit log entries.
+type AuditLogExportFormat string
+
+const (
+	// Newline delimited JSON, one entry per line.
+	AuditLogExportFormatNdjson AuditLogExportFormat = "NDJSON"
+	// Comma separated values, with a header row.
+	AuditLogExportFormatCSV AuditLogExportFormat = "CSV"
+)
+
+var AllAuditLogExportFormat = []AuditLogExportFormat{
+	AuditLogExportFormatNdjson,
+	AuditLogExportFormatCSV,
+}
+
+func (e AuditLogExportFormat) IsValid() bool {
+	switch e {
+	case AuditLogExportFormatNdjson, AuditLogExportFormatCSV:
+		return true
+	}
+	return false
+}
+
+func (e AuditLogExportFormat) String() string {
+	return string(e)
+}
+
+func (e *AuditLogExportFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditLogExportFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditLogExportFormat", str)
+	}
+	return nil
+}
+
+func (e AuditLogExportFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Fields to sort the collection by.
 type AuditLogSortField string
 
@@ -216,6 +405,50 @@ func (e AuditLogSortField) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// Ways to resolve a conflict with an existing GitHub team.
+type GitHubTeamConflictResolution string
+
+const (
+	// Adopt the existing GitHub team. Console will manage its members from now on.
+	GitHubTeamConflictResolutionAdopt GitHubTeamConflictResolution = "ADOPT"
+	// Create a GitHub team with another name.
+	GitHubTeamConflictResolutionAlternateName GitHubTeamConflictResolution = "ALTERNATE_NAME"
+)
+
+var AllGitHubTeamConflictResolution = []GitHubTeamConflictResolution{
+	GitHubTeamConflictResolutionAdopt,
+	GitHubTeamConflictResolutionAlternateName,
+}
+
+func (e GitHubTeamConflictResolution) IsValid() bool {
+	switch e {
+	case GitHubTeamConflictResolutionAdopt, GitHubTeamConflictResolutionAlternateName:
+		return true
+	}
+	return false
+}
+
+func (e GitHubTeamConflictResolution) String() string {
+	return string(e)
+}
+
+func (e *GitHubTeamConflictResolution) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GitHubTeamConflictResolution(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GitHubTeamConflictResolution", str)
+	}
+	return nil
+}
+
+func (e GitHubTeamConflictResolution) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Direction of the sort.
 type SortDirection string
 
@@ -348,6 +581,53 @@ func (e TeamSortField) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// Kinds of team synchronization events.
+type TeamSyncEventType string
+
+const (
+	// The synchronization has started.
+	TeamSyncEventTypeStarted TeamSyncEventType = "STARTED"
+	// The synchronization has finished without errors.
+	TeamSyncEventTypeFinished TeamSyncEventType = "FINISHED"
+	// The synchronization has failed.
+	TeamSyncEventTypeFailed TeamSyncEventType = "FAILED"
+)
+
+var AllTeamSyncEventType = []TeamSyncEventType{
+	TeamSyncEventTypeStarted,
+	TeamSyncEventTypeFinished,
+	TeamSyncEventTypeFailed,
+}
+
+func (e TeamSyncEventType) IsValid() bool {
+	switch e {
+	case TeamSyncEventTypeStarted, TeamSyncEventTypeFinished, TeamSyncEventTypeFailed:
+		return true
+	}
+	return false
+}
+
+func (e TeamSyncEventType) String() string {
+	return string(e)
+}
+
+func (e *TeamSyncEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TeamSyncEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TeamSyncEventType", str)
+	}
+	return nil
+}
+
+func (e TeamSyncEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Fields to sort the collection by.
 type UserSortField string
 
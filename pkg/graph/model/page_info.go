@@ -0,0 +1,44 @@
+package model
+
+import (
+	"context"
+	"sync"
+)
+
+// PageInfo Pagination metadata attached to queries resulting in a collection of data. The total number of results and
+// the offset of the page are counted when requested, as cursor pagination needs queries of their own to count them.
+type PageInfo struct {
+	// Maximum number of records included in the collection.
+	Limit int `json:"limit"`
+	// Whether or not there are more entries after the returned collection.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor of the last entry in the returned collection. Pass this as the after argument to get the next page.
+	EndCursor *string `json:"endCursor"`
+
+	// Count Count the results that match the query, and the record number the collection starts at
+	Count func(ctx context.Context) (results, offset int, err error)
+
+	once    sync.Once
+	results int
+	offset  int
+	err     error
+}
+
+// Results Total number of results that matches the query
+func (p *PageInfo) Results(ctx context.Context) (int, error) {
+	p.count(ctx)
+	return p.results, p.err
+}
+
+// Offset Which record number the returned collection starts at
+func (p *PageInfo) Offset(ctx context.Context) (int, error) {
+	p.count(ctx)
+	return p.offset, p.err
+}
+
+// count Count once, no matter how many of the counted fields are requested
+func (p *PageInfo) count(ctx context.Context) {
+	p.once.Do(func() {
+		p.results, p.offset, p.err = p.Count(ctx)
+	})
+}
@@ -1,33 +1,78 @@
 package model
 
 import (
+	"strings"
+
 	"github.com/nais/console/pkg/dbmodels"
+	"gorm.io/gorm"
 )
 
+// actionWildcard Suffix used in audit log action filters to match all actions with a given prefix
+const actionWildcard = "*"
+
 type Query interface {
+	// GetQuery Get a model used for exact matches
 	GetQuery() interface{}
+
+	// GetConditions Add conditions that can't be expressed using the model from GetQuery, for instance substring
+	// matches or time ranges
+	GetConditions(db *gorm.DB) *gorm.DB
 }
 
 type QueryOrder interface {
 	GetOrderString() string
+	GetSortField() string
+	GetSortDirection() SortDirection
 }
 
 func (order UsersSort) GetOrderString() string {
 	return string(order.Field) + " " + string(order.Direction)
 }
 
+func (order UsersSort) GetSortField() string {
+	return string(order.Field)
+}
+
+func (order UsersSort) GetSortDirection() SortDirection {
+	return order.Direction
+}
+
 func (order TeamsSort) GetOrderString() string {
 	return string(order.Field) + " " + string(order.Direction)
 }
 
+func (order TeamsSort) GetSortField() string {
+	return string(order.Field)
+}
+
+func (order TeamsSort) GetSortDirection() SortDirection {
+	return order.Direction
+}
+
 func (order AuditLogsSort) GetOrderString() string {
 	return string(order.Field) + " " + string(order.Direction)
 }
 
+func (order AuditLogsSort) GetSortField() string {
+	return string(order.Field)
+}
+
+func (order AuditLogsSort) GetSortDirection() SortDirection {
+	return order.Direction
+}
+
 func (order SystemsSort) GetOrderString() string {
 	return string(order.Field) + " " + string(order.Direction)
 }
 
+func (order SystemsSort) GetSortField() string {
+	return string(order.Field)
+}
+
+func (order SystemsSort) GetSortDirection() SortDirection {
+	return order.Direction
+}
+
 func (in *UsersQuery) GetQuery() interface{} {
 	if in == nil {
 		return &dbmodels.User{}
@@ -98,3 +143,71 @@ func (in *AuditLogsQuery) GetQuery() interface{} {
 
 	return entry
 }
+
+func (in *UsersQuery) GetConditions(db *gorm.DB) *gorm.DB {
+	if in == nil || in.Search == nil {
+		return db
+	}
+
+	return search(db, *in.Search, "name", "email")
+}
+
+func (in *TeamsQuery) GetConditions(db *gorm.DB) *gorm.DB {
+	if in == nil || in.Search == nil {
+		return db
+	}
+
+	return search(db, *in.Search, "slug", "name", "purpose")
+}
+
+func (in *SystemsQuery) GetConditions(db *gorm.DB) *gorm.DB {
+	return db
+}
+
+func (in *AuditLogsQuery) GetConditions(db *gorm.DB) *gorm.DB {
+	if in == nil {
+		return db
+	}
+
+	if in.Action != nil {
+		if strings.HasSuffix(*in.Action, actionWildcard) {
+			prefix := strings.TrimSuffix(*in.Action, actionWildcard)
+			db = db.Where(`action LIKE ? ESCAPE '\'`, escapeLike(prefix)+"%")
+		} else {
+			db = db.Where("action = ?", *in.Action)
+		}
+	}
+
+	if in.CreatedAfter != nil {
+		db = db.Where("created_at >= ?", *in.CreatedAfter)
+	}
+
+	if in.CreatedBefore != nil {
+		db = db.Where("created_at < ?", *in.CreatedBefore)
+	}
+
+	return db
+}
+
+// search Match rows where any of the columns contains the term, ignoring case
+func search(db *gorm.DB, term string, columns ...string) *gorm.DB {
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return db
+	}
+
+	pattern := "%" + escapeLike(strings.ToLower(term)) + "%"
+	conditions := make([]string, len(columns))
+	args := make([]interface{}, len(columns))
+	for i, column := range columns {
+		conditions[i] = "LOWER(" + column + `) LIKE ? ESCAPE '\'`
+		args[i] = pattern
+	}
+
+	return db.Where(strings.Join(conditions, " OR "), args...)
+}
+
+// escapeLike Escape characters with a special meaning in LIKE patterns
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
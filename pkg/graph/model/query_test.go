@@ -1,8 +1,12 @@
 package model
 
 import (
-	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
+
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestSortInputs(t *testing.T) {
@@ -38,3 +42,52 @@ func TestSortInputs(t *testing.T) {
 		assert.Equal(t, "name ASC", order.GetOrderString())
 	})
 }
+
+func TestAuditLogsQuery_GetConditions(t *testing.T) {
+	db := test.GetTestDB()
+	db.AutoMigrate(&dbmodels.AuditLog{})
+
+	now := time.Now()
+	for i, action := range []string{"github:team:create", "github:team:add-member", "azure:group:create", "github_team"} {
+		db.Create(&dbmodels.AuditLog{
+			Action:   action,
+			Message:  "message",
+			Sequence: int64(i + 1),
+			Model: dbmodels.Model{
+				CreatedAt: now.Add(time.Duration(i) * time.Hour),
+			},
+		})
+	}
+
+	find := func(query *AuditLogsQuery) []string {
+		entries := make([]*dbmodels.AuditLog, 0)
+		err := query.GetConditions(db.Model(&dbmodels.AuditLog{})).Order("created_at").Find(&entries).Error
+		assert.NoError(t, err)
+
+		actions := make([]string, len(entries))
+		for i, entry := range entries {
+			actions[i] = entry.Action
+		}
+		return actions
+	}
+
+	t.Run("Exact action", func(t *testing.T) {
+		action := "azure:group:create"
+		assert.Equal(t, []string{"azure:group:create"}, find(&AuditLogsQuery{Action: &action}))
+	})
+
+	t.Run("Action prefix", func(t *testing.T) {
+		action := "github:team:*"
+		assert.Equal(t, []string{"github:team:create", "github:team:add-member"}, find(&AuditLogsQuery{Action: &action}))
+	})
+
+	t.Run("Time range", func(t *testing.T) {
+		after := now.Add(time.Hour)
+		before := now.Add(3 * time.Hour)
+		assert.Equal(t, []string{"github:team:add-member", "azure:group:create"}, find(&AuditLogsQuery{CreatedAfter: &after, CreatedBefore: &before}))
+	})
+
+	t.Run("No query", func(t *testing.T) {
+		assert.Len(t, find(nil), 4)
+	})
+}
@@ -0,0 +1,147 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/authz"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/graph/model"
+	"github.com/nais/console/pkg/reconcilers"
+	console_reconciler "github.com/nais/console/pkg/reconcilers/console"
+	"github.com/nais/console/pkg/roles"
+	"gorm.io/gorm"
+)
+
+func (r *mutationResolver) SetTeamRepositories(ctx context.Context, teamID *uuid.UUID, repositories []*model.TeamRepositoryInput) (*dbmodels.Team, error) {
+	user := authz.UserFromContext(ctx)
+	err := authz.RequireTeamAuthorization(user, roles.AuthorizationTeamsUpdate, *teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	team := &dbmodels.Team{}
+	err = r.db.Where("id = ?", teamID).First(team).Error
+	if err != nil {
+		return nil, err
+	}
+
+	desired := make(map[string]dbmodels.RepositoryPermission, len(repositories))
+	for _, repository := range repositories {
+		err = dbmodels.ValidateRepositoryName(repository.Name)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := desired[repository.Name]; exists {
+			return nil, fmt.Errorf("repository '%s' is listed more than once", repository.Name)
+		}
+		desired[repository.Name] = repository.Permission
+	}
+
+	existing := make([]*dbmodels.TeamRepository, 0)
+	err = r.db.Where("team_id = ?", team.ID).Find(&existing).Error
+	if err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]dbmodels.RepositoryPermission, len(existing))
+	for _, repository := range existing {
+		current[repository.Name] = repository.Permission
+	}
+
+	for _, input := range repositories {
+		if !requiresRepositoryManagement(current, input) {
+			continue
+		}
+		err = authz.RequireGlobalAuthorization(user, roles.AuthorizationRepositoriesManage)
+		if err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	var corr *dbmodels.Correlation
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		corr, err = r.createCorrelation(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		for _, input := range repositories {
+			name, permission := input.Name, input.Permission
+			if current[name] == permission {
+				continue
+			}
+
+			repository := &dbmodels.TeamRepository{
+				TeamID: *team.ID,
+				Name:   name,
+			}
+			err = tx.Where("team_id = ? AND name = ?", team.ID, name).Assign(dbmodels.TeamRepository{Permission: permission}).FirstOrCreate(repository).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, repository := range existing {
+			name := repository.Name
+			if _, exists := desired[name]; exists {
+				continue
+			}
+
+			err = tx.Where("team_id = ? AND name = ?", team.ID, name).Delete(&dbmodels.TeamRepository{}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, input := range repositories {
+		if current[input.Name] != input.Permission {
+			details := console_reconciler.RepositoryDetails{
+				Repository: input.Name,
+				Permission: string(input.Permission),
+			}
+			r.auditLogger.LogWithDetails(console_reconciler.OpSetTeamRepository, *corr, *r.system, user, team, nil, details, "Set permission '%s' on repository '%s'", input.Permission, input.Name)
+		}
+	}
+	for _, repository := range existing {
+		if _, exists := desired[repository.Name]; !exists {
+			details := console_reconciler.RepositoryDetails{
+				Repository: repository.Name,
+			}
+			r.auditLogger.LogWithDetails(console_reconciler.OpRemoveTeamRepository, *corr, *r.system, user, team, nil, details, "Removed repository '%s'", repository.Name)
+		}
+	}
+
+	team, err = r.teamWithAssociations(*team.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch team: %w", err)
+	}
+
+	r.teamReconciler <- reconcilers.Input{
+		Corr: *corr,
+		Team: *team,
+	}
+
+	return team, nil
+}
+
+func (r *teamResolver) Repositories(ctx context.Context, obj *dbmodels.Team) ([]*dbmodels.TeamRepository, error) {
+	repositories := make([]*dbmodels.TeamRepository, 0)
+	err := r.db.WithContext(ctx).Where("team_id = ?", obj.ID).Order("name ASC").Find(&repositories).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return repositories, nil
+}
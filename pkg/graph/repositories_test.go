@@ -0,0 +1,126 @@
+package graph_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/authz"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/graph"
+	"github.com/nais/console/pkg/graph/model"
+	"github.com/nais/console/pkg/reconcilers"
+	console_reconciler "github.com/nais/console/pkg/reconcilers/console"
+	"github.com/nais/console/pkg/roles"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMutationResolver_SetTeamRepositories(t *testing.T) {
+	db := test.GetTestDB()
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Team{}, &dbmodels.TeamRepository{}, &dbmodels.TeamMetadata{}, &dbmodels.System{}, &dbmodels.Correlation{})
+
+	team := &dbmodels.Team{Slug: "team", Name: "Team"}
+	db.Create(team)
+
+	auditLogger := auditlogger.NewMockAuditLogger(t)
+	teamReconciler := make(chan reconcilers.Input, 100)
+	resolver := graph.NewResolver(db, "example.com", getSystem(), teamReconciler, auditLogger, nil, nil).Mutation()
+
+	owner := &dbmodels.User{RoleBindings: []dbmodels.UserRole{{
+		Role:     dbmodels.Role{Authorizations: []dbmodels.Authorization{{Name: string(roles.AuthorizationTeamsUpdate)}}},
+		TargetID: team.ID,
+	}}}
+	admin := &dbmodels.User{RoleBindings: []dbmodels.UserRole{
+		{
+			Role:     dbmodels.Role{Authorizations: []dbmodels.Authorization{{Name: string(roles.AuthorizationTeamsUpdate)}}},
+			TargetID: team.ID,
+		},
+		{
+			Role: dbmodels.Role{Authorizations: []dbmodels.Authorization{{Name: string(roles.AuthorizationRepositoriesManage)}}},
+		},
+	}}
+	ctx := authz.ContextWithUser(context.Background(), admin)
+	ownerCtx := authz.ContextWithUser(context.Background(), owner)
+
+	repositories := func() map[string]dbmodels.RepositoryPermission {
+		rows := make([]*dbmodels.TeamRepository, 0)
+		db.Where("team_id = ?", team.ID).Find(&rows)
+		result := make(map[string]dbmodels.RepositoryPermission)
+		for _, row := range rows {
+			result[row.Name] = row.Permission
+		}
+		return result
+	}
+
+	t.Run("Not authorized", func(t *testing.T) {
+		ctx := authz.ContextWithUser(context.Background(), &dbmodels.User{})
+		_, err := resolver.SetTeamRepositories(ctx, team.ID, []*model.TeamRepositoryInput{})
+		assert.ErrorIs(t, err, authz.ErrNotAuthorized)
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		_, err := resolver.SetTeamRepositories(ctx, team.ID, []*model.TeamRepositoryInput{
+			{Name: "other-org/repo", Permission: dbmodels.RepositoryPermissionRead},
+		})
+		assert.Error(t, err)
+
+		_, err = resolver.SetTeamRepositories(ctx, team.ID, []*model.TeamRepositoryInput{
+			{Name: "repo", Permission: dbmodels.RepositoryPermissionRead},
+			{Name: "repo", Permission: dbmodels.RepositoryPermissionAdmin},
+		})
+		assert.Error(t, err)
+		assert.Empty(t, repositories())
+	})
+
+	t.Run("Set, change and remove", func(t *testing.T) {
+		auditLogger.On("LogWithDetails", console_reconciler.OpSetTeamRepository, mock.Anything, mock.Anything, admin, mock.Anything, mock.Anything, console_reconciler.RepositoryDetails{Repository: "api", Permission: string(dbmodels.RepositoryPermissionWrite)}, mock.Anything, dbmodels.RepositoryPermissionWrite, "api").Return(nil).Once()
+		auditLogger.On("LogWithDetails", console_reconciler.OpSetTeamRepository, mock.Anything, mock.Anything, admin, mock.Anything, mock.Anything, console_reconciler.RepositoryDetails{Repository: "docs", Permission: string(dbmodels.RepositoryPermissionRead)}, mock.Anything, dbmodels.RepositoryPermissionRead, "docs").Return(nil).Once()
+		_, err := resolver.SetTeamRepositories(ctx, team.ID, []*model.TeamRepositoryInput{
+			{Name: "api", Permission: dbmodels.RepositoryPermissionWrite},
+			{Name: "docs", Permission: dbmodels.RepositoryPermissionRead},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]dbmodels.RepositoryPermission{
+			"api":  dbmodels.RepositoryPermissionWrite,
+			"docs": dbmodels.RepositoryPermissionRead,
+		}, repositories())
+		assert.Len(t, teamReconciler, 1)
+
+		auditLogger.On("LogWithDetails", console_reconciler.OpSetTeamRepository, mock.Anything, mock.Anything, admin, mock.Anything, mock.Anything, console_reconciler.RepositoryDetails{Repository: "api", Permission: string(dbmodels.RepositoryPermissionAdmin)}, mock.Anything, dbmodels.RepositoryPermissionAdmin, "api").Return(nil).Once()
+		auditLogger.On("LogWithDetails", console_reconciler.OpRemoveTeamRepository, mock.Anything, mock.Anything, admin, mock.Anything, mock.Anything, console_reconciler.RepositoryDetails{Repository: "docs"}, mock.Anything, "docs").Return(nil).Once()
+		_, err = resolver.SetTeamRepositories(ctx, team.ID, []*model.TeamRepositoryInput{
+			{Name: "api", Permission: dbmodels.RepositoryPermissionAdmin},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]dbmodels.RepositoryPermission{
+			"api": dbmodels.RepositoryPermissionAdmin,
+		}, repositories())
+		assert.Len(t, teamReconciler, 2)
+	})
+
+	t.Run("Team owner can not claim repositories or grant admin", func(t *testing.T) {
+		_, err := resolver.SetTeamRepositories(ownerCtx, team.ID, []*model.TeamRepositoryInput{
+			{Name: "api", Permission: dbmodels.RepositoryPermissionAdmin},
+			{Name: "other-teams-repo", Permission: dbmodels.RepositoryPermissionRead},
+		})
+		assert.ErrorIs(t, err, authz.ErrNotAuthorized)
+
+		auditLogger.On("LogWithDetails", console_reconciler.OpSetTeamRepository, mock.Anything, mock.Anything, owner, mock.Anything, mock.Anything, console_reconciler.RepositoryDetails{Repository: "api", Permission: string(dbmodels.RepositoryPermissionWrite)}, mock.Anything, dbmodels.RepositoryPermissionWrite, "api").Return(nil).Once()
+		_, err = resolver.SetTeamRepositories(ownerCtx, team.ID, []*model.TeamRepositoryInput{
+			{Name: "api", Permission: dbmodels.RepositoryPermissionWrite},
+		})
+		assert.NoError(t, err)
+
+		_, err = resolver.SetTeamRepositories(ownerCtx, team.ID, []*model.TeamRepositoryInput{
+			{Name: "api", Permission: dbmodels.RepositoryPermissionMaintain},
+		})
+		assert.ErrorIs(t, err, authz.ErrNotAuthorized)
+
+		auditLogger.On("LogWithDetails", console_reconciler.OpRemoveTeamRepository, mock.Anything, mock.Anything, owner, mock.Anything, mock.Anything, console_reconciler.RepositoryDetails{Repository: "api"}, mock.Anything, "api").Return(nil).Once()
+		_, err = resolver.SetTeamRepositories(ownerCtx, team.ID, []*model.TeamRepositoryInput{})
+		assert.NoError(t, err)
+		assert.Empty(t, repositories())
+	})
+}
@@ -3,14 +3,20 @@ package graph
 import (
 	"context"
 	"fmt"
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/google/uuid"
 	"github.com/jackc/pgconn"
 	"github.com/nais/console/pkg/auditlogger"
 	"github.com/nais/console/pkg/authz"
+	"github.com/nais/console/pkg/dataloader"
 	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/events"
 	"github.com/nais/console/pkg/graph/model"
+	"github.com/nais/console/pkg/notifications"
 	"github.com/nais/console/pkg/reconcilers"
+	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"reflect"
 )
 
 // This file will not be regenerated automatically.
@@ -27,18 +33,32 @@ type Resolver struct {
 	teamReconciler chan<- reconcilers.Input
 	system         *dbmodels.System
 	auditLogger    auditlogger.AuditLogger
+	events         events.Subscriber
+	notifier       notifications.Notifier
 }
 
-func NewResolver(db *gorm.DB, tenantDomain string, system *dbmodels.System, teamReconciler chan<- reconcilers.Input, auditLogger auditlogger.AuditLogger) *Resolver {
+func NewResolver(db *gorm.DB, tenantDomain string, system *dbmodels.System, teamReconciler chan<- reconcilers.Input, auditLogger auditlogger.AuditLogger, subscriber events.Subscriber, notifier notifications.Notifier) *Resolver {
 	return &Resolver{
 		db:             db,
 		tenantDomain:   tenantDomain,
 		system:         system,
 		teamReconciler: teamReconciler,
 		auditLogger:    auditLogger,
+		events:         subscriber,
+		notifier:       notifier,
 	}
 }
 
+// loaders Get the data loaders for the current request. Requests without loaders, for instance over websockets, get a
+// fresh set, so objects are always fetched, but not batched.
+func (r *Resolver) loaders(ctx context.Context) *dataloader.Loaders {
+	loaders := dataloader.FromContext(ctx)
+	if loaders == nil {
+		return dataloader.NewLoaders(ctx, r.db)
+	}
+	return loaders
+}
+
 // Model Enables abstracted access to CreatedBy and UpdatedBy for generic database models.
 type Model interface {
 	GetModel() *dbmodels.Model
@@ -87,6 +107,59 @@ func (r *Resolver) updateTrackedObject(ctx context.Context, updatedObject Model)
 	return r.db.Updates(updatedObject).Error
 }
 
+// createCorrelation Create a correlation for the changes made by the current mutation. The authenticated user is
+// recorded as the initiator of the change.
+func (r *Resolver) createCorrelation(ctx context.Context, tx *gorm.DB) (*dbmodels.Correlation, error) {
+	corr := &dbmodels.Correlation{
+		Trigger: dbmodels.CorrelationTriggerMutation,
+	}
+
+	if user := authz.UserFromContext(ctx); user != nil {
+		corr.CreatedByID = user.ID
+		corr.UpdatedByID = user.ID
+	}
+
+	if field := graphql.GetFieldContext(ctx); field != nil {
+		corr.Operation = field.Field.Name
+	}
+
+	err := tx.Create(corr).Error
+	if err != nil {
+		return nil, fmt.Errorf("unable to create correlation for audit log")
+	}
+
+	return corr, nil
+}
+
+// teamMemberIDs Get the IDs of the current members of a team
+func (r *Resolver) teamMemberIDs(teamID uuid.UUID) (map[uuid.UUID]bool, error) {
+	userIDs := make([]uuid.UUID, 0)
+	err := r.db.Model(&dbmodels.UserTeam{}).Where("team_id = ?", teamID).Pluck("user_id", &userIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[uuid.UUID]bool)
+	for _, userID := range userIDs {
+		members[userID] = true
+	}
+
+	return members, nil
+}
+
+// notifyMembershipChanged Notify the team about added and removed members. Failing to notify the team is not fatal to
+// the mutation.
+func (r *Resolver) notifyMembershipChanged(ctx context.Context, corr dbmodels.Correlation, team dbmodels.Team, added, removed []*dbmodels.User) {
+	if r.notifier == nil {
+		return
+	}
+
+	err := r.notifier.MembershipChanged(ctx, corr, team, authz.UserFromContext(ctx), added, removed)
+	if err != nil {
+		log.Warnf("unable to notify team '%s' about membership changes: %s", team.Slug, err)
+	}
+}
+
 // Update the deleted_by_id column before "deleting" the object.
 // When using UpdateColumn the update time tracking is not updated.
 func (r *Resolver) deleteTrackedObject(ctx context.Context, objectToDelete SoftDeleteModel) error {
@@ -98,17 +171,47 @@ func (r *Resolver) deleteTrackedObject(ctx context.Context, objectToDelete SoftD
 	return r.db.Model(objectToDelete).UpdateColumn("deleted_by_id", user.ID).Delete(objectToDelete).Error
 }
 
-// Run a query to get data from the database. Populates `collection` and returns pagination metadata.
-func (r *Resolver) paginatedQuery(pagination *model.Pagination, query model.Query, sort model.QueryOrder, dbModel interface{}, collection interface{}) (*model.PageInfo, error) {
-	if pagination == nil {
-		pagination = &model.Pagination{
-			Offset: 0,
-			Limit:  50,
+// Run a query to get data from the database. Populates `collection` and returns pagination metadata, along with the
+// cursor of each entry in the collection. Cursor pagination is used if first or after is set, otherwise the query falls
+// back to offset pagination.
+func (r *Resolver) paginatedQuery(ctx context.Context, pagination *model.Pagination, first *int, after *string, query model.Query, sort model.QueryOrder, dbModel interface{}, collection interface{}) (*model.PageInfo, []string, error) {
+	key, err := newSortKey(r.db, dbModel, sort)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db := key.order(query.GetConditions(r.db.Model(dbModel).Where(query.GetQuery())))
+
+	var pageInfo *model.PageInfo
+	if first != nil || after != nil {
+		if pagination != nil {
+			return nil, nil, fmt.Errorf("offset pagination can not be combined with first and after")
+		}
+		pageInfo, err = r.withCursor(first, after, key, db, collection)
+	} else {
+		if pagination == nil {
+			pagination = &model.Pagination{
+				Offset: 0,
+				Limit:  defaultPageSize,
+			}
 		}
+		pageInfo, db = r.withPagination(pagination, db)
+		err = db.Find(collection).Error
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cursors, err := key.cursors(ctx, collection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(cursors) > 0 {
+		pageInfo.EndCursor = &cursors[len(cursors)-1]
 	}
-	db := r.db.Model(dbModel).Where(query.GetQuery()).Order(sort.GetOrderString())
-	pageInfo, db := r.withPagination(pagination, db)
-	return pageInfo, db.Find(collection).Error
+
+	return pageInfo, cursors, nil
 }
 
 // Limit a query by its pagination parameters, count number of rows in dataset, and return pagination metadata.
@@ -117,12 +220,68 @@ func (r *Resolver) withPagination(pagination *model.Pagination, db *gorm.DB) (*m
 	db = db.Count(&count).Limit(pagination.Limit).Offset(pagination.Offset)
 
 	return &model.PageInfo{
-		Results: int(count),
-		Offset:  pagination.Offset,
-		Limit:   pagination.Limit,
+		Limit:       pagination.Limit,
+		HasNextPage: int64(pagination.Offset+pagination.Limit) < count,
+		Count: func(context.Context) (int, int, error) {
+			return int(count), pagination.Offset, nil
+		},
 	}, db
 }
 
+// Populate `collection` with the page of entries following the cursor. One entry more than requested is fetched to find
+// out if there is a next page. The total number of results and the offset of the page are only counted when requested.
+// The offset is the number of entries that match the query, minus the number of entries following the cursor.
+func (r *Resolver) withCursor(first *int, after *string, key *sortKey, db *gorm.DB, collection interface{}) (*model.PageInfo, error) {
+	limit := defaultPageSize
+	if first != nil {
+		if *first < 1 || *first > maxPageSize {
+			return nil, fmt.Errorf("first must be between 1 and %d", maxPageSize)
+		}
+		limit = *first
+	}
+
+	all := db.Session(&gorm.Session{})
+	following := all
+	if after != nil {
+		var err error
+		following, err = key.after(all, *after)
+		if err != nil {
+			return nil, err
+		}
+		following = following.Session(&gorm.Session{})
+	}
+
+	err := following.Limit(limit + 1).Find(collection).Error
+	if err != nil {
+		return nil, err
+	}
+
+	entries := reflect.ValueOf(collection).Elem()
+	hasNextPage := entries.Len() > limit
+	if hasNextPage {
+		entries.Set(entries.Slice(0, limit))
+	}
+
+	return &model.PageInfo{
+		Limit:       limit,
+		HasNextPage: hasNextPage,
+		Count: func(ctx context.Context) (int, int, error) {
+			var results, remaining int64
+			err := all.WithContext(ctx).Count(&results).Error
+			if err != nil {
+				return 0, 0, err
+			}
+
+			err = following.WithContext(ctx).Count(&remaining).Error
+			if err != nil {
+				return 0, 0, err
+			}
+
+			return int(results), int(results - remaining), nil
+		},
+	}, nil
+}
+
 func (r *mutationResolver) teamWithAssociations(teamID uuid.UUID) (*dbmodels.Team, error) {
 	team := &dbmodels.Team{}
 	err := r.db.
@@ -137,3 +296,60 @@ func (r *mutationResolver) teamWithAssociations(teamID uuid.UUID) (*dbmodels.Tea
 
 	return team, nil
 }
+
+var teamSyncEventTypes = map[events.Type]model.TeamSyncEventType{
+	events.TypeTeamSyncStarted:  model.TeamSyncEventTypeStarted,
+	events.TypeTeamSyncFinished: model.TeamSyncEventTypeFinished,
+	events.TypeTeamSyncFailed:   model.TeamSyncEventTypeFailed,
+}
+
+// teamSyncEvent Convert an event to a team sync event for the GraphQL API. Returns nil if the event is not related to
+// team synchronization.
+func (r *subscriptionResolver) teamSyncEvent(team *dbmodels.Team, event events.Event) (*model.TeamSyncEvent, error) {
+	eventType, exists := teamSyncEventTypes[event.Type]
+	if !exists {
+		return nil, nil
+	}
+
+	corr := &dbmodels.Correlation{}
+	err := r.db.Where("id = ?", event.CorrelationID).First(corr).Error
+	if err != nil {
+		return nil, err
+	}
+
+	syncEvent := &model.TeamSyncEvent{
+		Type:        eventType,
+		Team:        team,
+		Correlation: corr,
+		CreatedAt:   event.CreatedAt,
+	}
+
+	if event.SystemID != nil {
+		system := &dbmodels.System{}
+		err := r.db.Where("id = ?", event.SystemID).First(system).Error
+		if err != nil {
+			return nil, err
+		}
+		syncEvent.System = system
+	}
+
+	if event.Message != "" {
+		syncEvent.Message = &event.Message
+	}
+
+	return syncEvent, nil
+}
+
+// requiresRepositoryManagement Check if the change needs the global repositories.manage authorization. Teams may give
+// themselves access to any repository in the organization through the GitHub reconciler, so only administrators can
+// claim new repositories, or grant permissions that include managing the repository.
+func requiresRepositoryManagement(current map[string]dbmodels.RepositoryPermission, input *model.TeamRepositoryInput) bool {
+	permission, exists := current[input.Name]
+	if !exists {
+		return true
+	}
+	if permission == input.Permission {
+		return false
+	}
+	return input.Permission == dbmodels.RepositoryPermissionMaintain || input.Permission == dbmodels.RepositoryPermissionAdmin
+}
@@ -0,0 +1,47 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/graph/model"
+)
+
+func (r *queryResolver) Search(ctx context.Context, query string, limit int) ([]model.SearchResult, error) {
+	if limit < 1 {
+		return nil, fmt.Errorf("limit must be a positive integer")
+	}
+
+	if strings.TrimSpace(query) == "" {
+		return []model.SearchResult{}, nil
+	}
+
+	teams := make([]*dbmodels.Team, 0)
+	teamsQuery := &model.TeamsQuery{Search: &query}
+	err := teamsQuery.GetConditions(r.db.Model(&dbmodels.Team{})).Order("slug").Limit(limit).Find(&teams).Error
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*dbmodels.User, 0)
+	usersQuery := &model.UsersQuery{Search: &query}
+	err = usersQuery.GetConditions(r.db.Model(&dbmodels.User{})).Order("name").Limit(limit).Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]model.SearchResult, 0, len(teams)+len(users))
+	for _, team := range teams {
+		results = append(results, team)
+	}
+	for _, user := range users {
+		results = append(results, user)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,65 @@
+package graph_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/graph"
+	"github.com/nais/console/pkg/reconcilers"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryResolver_Search(t *testing.T) {
+	db := test.GetTestDB()
+	db.AutoMigrate(&dbmodels.Team{}, &dbmodels.User{})
+	purpose := "Platform tooling"
+	db.Create([]dbmodels.Team{
+		{Slug: "aura", Name: "Aura", Purpose: &purpose},
+		{Slug: "teamsykmelding", Name: "Team Sykmelding"},
+	})
+	db.Create([]dbmodels.User{
+		{Name: "Ola Nordmann", Email: "ola@example.com"},
+		{Name: "Kari Nordmann", Email: "kari_100%@example.com"},
+	})
+
+	ch := make(chan reconcilers.Input, 100)
+	resolver := graph.NewResolver(db, "example.com", getSystem(), ch, nil, nil, nil).Query()
+	ctx := context.Background()
+
+	t.Run("Teams and users are matched ignoring case", func(t *testing.T) {
+		results, err := resolver.Search(ctx, "PLATFORM", 20)
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, "aura", results[0].(*dbmodels.Team).Slug.String())
+
+		results, err = resolver.Search(ctx, "nordmann", 20)
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+		assert.Equal(t, "Kari Nordmann", results[0].(*dbmodels.User).Name)
+		assert.Equal(t, "Ola Nordmann", results[1].(*dbmodels.User).Name)
+	})
+
+	t.Run("Wildcards in the search term are matched literally", func(t *testing.T) {
+		results, err := resolver.Search(ctx, "_100%", 20)
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, "Kari Nordmann", results[0].(*dbmodels.User).Name)
+
+		results, err = resolver.Search(ctx, "%", 20)
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+	})
+
+	t.Run("Limit is applied per type", func(t *testing.T) {
+		results, err := resolver.Search(ctx, "a", 1)
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+	})
+
+	t.Run("Invalid limit", func(t *testing.T) {
+		_, err := resolver.Search(ctx, "a", 0)
+		assert.Error(t, err)
+	})
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/nais/console/pkg/graph/model"
 )
 
-func (r *queryResolver) Systems(ctx context.Context, pagination *model.Pagination, query *model.SystemsQuery, sort *model.SystemsSort) (*model.Systems, error) {
+func (r *queryResolver) Systems(ctx context.Context, pagination *model.Pagination, first *int, after *string, query *model.SystemsQuery, sort *model.SystemsSort) (*model.Systems, error) {
 	systems := make([]*dbmodels.System, 0)
 
 	if sort == nil {
@@ -19,10 +19,22 @@ func (r *queryResolver) Systems(ctx context.Context, pagination *model.Paginatio
 			Direction: model.SortDirectionAsc,
 		}
 	}
-	pageInfo, err := r.paginatedQuery(pagination, query, sort, &dbmodels.System{}, &systems)
+	pageInfo, cursors, err := r.paginatedQuery(ctx, pagination, first, after, query, sort, &dbmodels.System{}, &systems)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*model.SystemEdge, len(systems))
+	for i := range systems {
+		edges[i] = &model.SystemEdge{
+			Cursor: cursors[i],
+			Node:   systems[i],
+		}
+	}
 
 	return &model.Systems{
 		PageInfo: pageInfo,
 		Nodes:    systems,
-	}, err
+		Edges:    edges,
+	}, nil
 }
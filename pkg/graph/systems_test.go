@@ -33,10 +33,10 @@ func TestQueryResolver_Systems(t *testing.T) {
 	ctx := context.Background()
 
 	logger := auditlogger.New(db)
-	resolver := graph.NewResolver(db, "example.com", system, ch, logger).Query()
+	resolver := graph.NewResolver(db, "example.com", system, ch, logger, nil, nil).Query()
 
 	t.Run("No filter or sort", func(t *testing.T) {
-		systems, err := resolver.Systems(ctx, nil, nil, nil)
+		systems, err := resolver.Systems(ctx, nil, nil, nil, nil, nil)
 		assert.NoError(t, err)
 
 		assert.Len(t, systems.Nodes, 3)
@@ -46,7 +46,7 @@ func TestQueryResolver_Systems(t *testing.T) {
 	})
 
 	t.Run("Sort name DESC", func(t *testing.T) {
-		systems, err := resolver.Systems(ctx, nil, nil, &model.SystemsSort{
+		systems, err := resolver.Systems(ctx, nil, nil, nil, nil, &model.SystemsSort{
 			Field:     model.SystemSortFieldName,
 			Direction: model.SortDirectionDesc,
 		})
@@ -6,21 +6,25 @@ package graph
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/nais/console/pkg/authz"
 	"github.com/nais/console/pkg/dbmodels"
 	"github.com/nais/console/pkg/graph/generated"
 	"github.com/nais/console/pkg/graph/model"
+	"github.com/nais/console/pkg/notifications"
 	"github.com/nais/console/pkg/reconcilers"
 	console_reconciler "github.com/nais/console/pkg/reconcilers/console"
+	github_team_reconciler "github.com/nais/console/pkg/reconcilers/github/team"
 	"github.com/nais/console/pkg/roles"
+	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
 func (r *mutationResolver) CreateTeam(ctx context.Context, input model.CreateTeamInput) (*dbmodels.Team, error) {
 	user := authz.UserFromContext(ctx)
-	corr := &dbmodels.Correlation{}
+	var corr *dbmodels.Correlation
 	team := &dbmodels.Team{
 		Slug:    *input.Slug,
 		Name:    input.Name,
@@ -28,9 +32,10 @@ func (r *mutationResolver) CreateTeam(ctx context.Context, input model.CreateTea
 	}
 
 	err := r.db.Transaction(func(tx *gorm.DB) error {
-		err := tx.Create(corr).Error
+		var err error
+		corr, err = r.createCorrelation(ctx, tx)
 		if err != nil {
-			return fmt.Errorf("unable to create correlation for audit log")
+			return err
 		}
 
 		err = r.createTrackedObject(ctx, team)
@@ -102,11 +107,23 @@ func (r *mutationResolver) AddUsersToTeam(ctx context.Context, input model.AddUs
 		return nil, fmt.Errorf("one or more non-existing or duplicate user IDs given as parameter")
 	}
 
-	corr := &dbmodels.Correlation{}
+	members, err := r.teamMemberIDs(*team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	added := make([]*dbmodels.User, 0)
+	for _, user := range users {
+		if !members[*user.ID] {
+			added = append(added, user)
+		}
+	}
+
+	var corr *dbmodels.Correlation
 	err = r.db.Transaction(func(tx *gorm.DB) error {
-		err = tx.Create(corr).Error
+		corr, err = r.createCorrelation(ctx, tx)
 		if err != nil {
-			return fmt.Errorf("unable to create correlation for audit log")
+			return err
 		}
 
 		for _, userId := range input.UserIds {
@@ -131,6 +148,8 @@ func (r *mutationResolver) AddUsersToTeam(ctx context.Context, input model.AddUs
 		return nil, fmt.Errorf("unable to fetch team: %w", err)
 	}
 
+	r.notifyMembershipChanged(ctx, *corr, *team, added, nil)
+
 	r.teamReconciler <- reconcilers.Input{
 		Corr: *corr,
 		Team: *team,
@@ -156,11 +175,23 @@ func (r *mutationResolver) RemoveUsersFromTeam(ctx context.Context, input model.
 		return nil, fmt.Errorf("one or more non-existing or duplicate user IDs given as parameter")
 	}
 
-	corr := &dbmodels.Correlation{}
+	members, err := r.teamMemberIDs(*team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := make([]*dbmodels.User, 0)
+	for _, user := range users {
+		if members[*user.ID] {
+			removed = append(removed, user)
+		}
+	}
+
+	var corr *dbmodels.Correlation
 	err = r.db.Transaction(func(tx *gorm.DB) error {
-		err = tx.Create(corr).Error
+		corr, err = r.createCorrelation(ctx, tx)
 		if err != nil {
-			return fmt.Errorf("unable to create correlation for audit log")
+			return err
 		}
 
 		err = tx.Where("user_id IN (?) AND team_id = ?", input.UserIds, team.ID).Delete(&dbmodels.UserTeam{}).Error
@@ -179,6 +210,9 @@ func (r *mutationResolver) RemoveUsersFromTeam(ctx context.Context, input model.
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch team: %w", err)
 	}
+
+	r.notifyMembershipChanged(ctx, *corr, *team, nil, removed)
+
 	r.teamReconciler <- reconcilers.Input{
 		Corr: *corr,
 		Team: *team,
@@ -194,10 +228,9 @@ func (r *mutationResolver) SynchronizeTeam(ctx context.Context, teamID *uuid.UUI
 		return false, err
 	}
 
-	corr := &dbmodels.Correlation{}
-	err = r.db.Create(corr).Error
+	corr, err := r.createCorrelation(ctx, r.db)
 	if err != nil {
-		return false, fmt.Errorf("unable to create correlation for audit log")
+		return false, err
 	}
 
 	r.auditLogger.Logf(console_reconciler.OpSyncTeam, *corr, *r.system, authz.UserFromContext(ctx), team, nil, "Manual sync requested")
@@ -215,7 +248,152 @@ func (r *mutationResolver) SynchronizeTeam(ctx context.Context, teamID *uuid.UUI
 	return true, nil
 }
 
-func (r *queryResolver) Teams(ctx context.Context, pagination *model.Pagination, query *model.TeamsQuery, sort *model.TeamsSort) (*model.Teams, error) {
+func (r *mutationResolver) SetTeamMetadata(ctx context.Context, teamID *uuid.UUID, key string, value *string) (*dbmodels.Team, error) {
+	user := authz.UserFromContext(ctx)
+	err := authz.RequireTeamAuthorization(user, roles.AuthorizationTeamsUpdate, *teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	team := &dbmodels.Team{}
+	err = r.db.Where("id = ?", teamID).First(team).Error
+	if err != nil {
+		return nil, err
+	}
+
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return nil, fmt.Errorf("metadata key must not be empty")
+	}
+
+	// The parent team decides which repositories the members of the GitHub team can access
+	if key == github_team_reconciler.MetadataParentTeam {
+		err = authz.RequireGlobalAuthorization(user, roles.AuthorizationSystemStatesUpdate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Console posts notifications to the webhooks, so they must not point to internal services
+	if notifications.IsWebhook(key) && value != nil && *value != "" {
+		err = notifications.ValidateWebhookURL(ctx, *value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var corr *dbmodels.Correlation
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		corr, err = r.createCorrelation(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if value == nil || *value == "" {
+			return tx.Where("team_id = ? AND key = ?", team.ID, key).Delete(&dbmodels.TeamMetadata{}).Error
+		}
+
+		metadata := &dbmodels.TeamMetadata{
+			TeamID: *team.ID,
+			Key:    key,
+		}
+		return tx.Where("team_id = ? AND key = ?", team.ID, key).Assign(dbmodels.TeamMetadata{Value: value}).FirstOrCreate(metadata).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The value is not logged, as it may contain secrets such as webhook URLs
+	if value == nil || *value == "" {
+		r.auditLogger.Logf(console_reconciler.OpClearTeamMetadata, *corr, *r.system, user, team, nil, "Removed team metadata '%s'", key)
+	} else {
+		r.auditLogger.Logf(console_reconciler.OpSetTeamMetadata, *corr, *r.system, user, team, nil, "Set team metadata '%s'", key)
+	}
+
+	return team, nil
+}
+
+func (r *mutationResolver) ResolveGitHubTeamConflict(ctx context.Context, teamID *uuid.UUID, resolution model.GitHubTeamConflictResolution, name *string) (*dbmodels.Team, error) {
+	user := authz.UserFromContext(ctx)
+	err := authz.RequireGlobalAuthorization(user, roles.AuthorizationSystemStatesUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	team := &dbmodels.Team{}
+	err = r.db.Where("id = ?", teamID).First(team).Error
+	if err != nil {
+		return nil, err
+	}
+
+	system := &dbmodels.System{}
+	err = r.db.Where("name = ?", github_team_reconciler.Name).First(system).Error
+	if err != nil {
+		return nil, fmt.Errorf("unable to find system '%s': %w", github_team_reconciler.Name, err)
+	}
+
+	state := &reconcilers.GitHubState{}
+	err = dbmodels.LoadSystemState(r.db, *system.ID, *team.ID, state)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.ID != nil {
+		return nil, fmt.Errorf("team '%s' is already connected to a GitHub team", team.Slug)
+	}
+
+	var message string
+	details := console_reconciler.GitHubTeamConflictDetails{
+		Resolution: string(resolution),
+	}
+	switch resolution {
+	case model.GitHubTeamConflictResolutionAdopt:
+		state.Slug = team.Slug.StringP()
+		state.Name = nil
+		details.Name = team.Slug.String()
+		message = fmt.Sprintf("Adopt existing GitHub team '%s'", team.Slug)
+	case model.GitHubTeamConflictResolutionAlternateName:
+		if name == nil || strings.TrimSpace(*name) == "" {
+			return nil, fmt.Errorf("a name is required for the GitHub team")
+		}
+		alternateName := strings.TrimSpace(*name)
+		state.Slug = nil
+		state.Name = &alternateName
+		details.Name = alternateName
+		message = fmt.Sprintf("Create GitHub team with the name '%s'", alternateName)
+	default:
+		return nil, fmt.Errorf("unknown resolution '%s'", resolution)
+	}
+
+	var corr *dbmodels.Correlation
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		corr, err = r.createCorrelation(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		return dbmodels.SetSystemState(tx, *system.ID, *team.ID, state)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.auditLogger.LogWithDetails(console_reconciler.OpResolveGitHubTeamConflict, *corr, *r.system, user, team, nil, details, "%s", message)
+
+	team, err = r.teamWithAssociations(*team.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch team: %w", err)
+	}
+
+	r.teamReconciler <- reconcilers.Input{
+		Corr: *corr,
+		Team: *team,
+	}
+
+	return team, nil
+}
+
+func (r *queryResolver) Teams(ctx context.Context, pagination *model.Pagination, first *int, after *string, query *model.TeamsQuery, sort *model.TeamsSort) (*model.Teams, error) {
 	teams := make([]*dbmodels.Team, 0)
 	if sort == nil {
 		sort = &model.TeamsSort{
@@ -223,11 +401,24 @@ func (r *queryResolver) Teams(ctx context.Context, pagination *model.Pagination,
 			Direction: model.SortDirectionAsc,
 		}
 	}
-	pageInfo, err := r.paginatedQuery(pagination, query, sort, &dbmodels.Team{}, &teams)
+	pageInfo, cursors, err := r.paginatedQuery(ctx, pagination, first, after, query, sort, &dbmodels.Team{}, &teams)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*model.TeamEdge, len(teams))
+	for i := range teams {
+		edges[i] = &model.TeamEdge{
+			Cursor: cursors[i],
+			Node:   teams[i],
+		}
+	}
+
 	return &model.Teams{
 		PageInfo: pageInfo,
 		Nodes:    teams,
-	}, err
+		Edges:    edges,
+	}, nil
 }
 
 func (r *queryResolver) Team(ctx context.Context, id *uuid.UUID) (*dbmodels.Team, error) {
@@ -240,13 +431,48 @@ func (r *queryResolver) Team(ctx context.Context, id *uuid.UUID) (*dbmodels.Team
 	return team, nil
 }
 
-func (r *teamResolver) Users(ctx context.Context, obj *dbmodels.Team) ([]*dbmodels.User, error) {
-	users := make([]*dbmodels.User, 0)
-	err := r.db.Model(obj).Association("Users").Find(&users)
+func (r *subscriptionResolver) TeamSync(ctx context.Context, teamID *uuid.UUID) (<-chan *model.TeamSyncEvent, error) {
+	team := &dbmodels.Team{}
+	err := r.db.Where("id = ?", teamID).First(team).Error
 	if err != nil {
 		return nil, err
 	}
-	return users, nil
+
+	teamEvents, unsubscribe := r.events.Subscribe(*team.ID)
+	ch := make(chan *model.TeamSyncEvent)
+
+	go func() {
+		defer close(ch)
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-teamEvents:
+				syncEvent, err := r.teamSyncEvent(team, event)
+				if err != nil {
+					log.Warnf("unable to send team sync event: %s", err)
+					continue
+				}
+				if syncEvent == nil {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- syncEvent:
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func (r *teamResolver) Users(ctx context.Context, obj *dbmodels.Team) ([]*dbmodels.User, error) {
+	return r.loaders(ctx).TeamUsers.Load(ctx, *obj.ID)
 }
 
 func (r *teamResolver) Metadata(ctx context.Context, obj *dbmodels.Team) (map[string]interface{}, error) {
@@ -256,9 +482,15 @@ func (r *teamResolver) Metadata(ctx context.Context, obj *dbmodels.Team) (map[st
 		return nil, err
 	}
 
+	// Webhook URLs grant anyone who knows them the right to post to the team, so only show them to those who may change them
+	showWebhooks := authz.RequireTeamAuthorization(authz.UserFromContext(ctx), roles.AuthorizationTeamsUpdate, *obj.ID) == nil
+
 	kv := make(map[string]interface{})
 
 	for _, pair := range metadata {
+		if notifications.IsWebhook(pair.Key) && !showWebhooks {
+			continue
+		}
 		kv[pair.Key] = pair.Value
 	}
 
@@ -274,6 +506,26 @@ func (r *teamResolver) AuditLogs(ctx context.Context, obj *dbmodels.Team) ([]*db
 	return auditLogs, nil
 }
 
+func (r *teamResolver) ReconcileResults(ctx context.Context, obj *dbmodels.Team) ([]*dbmodels.ReconcileResult, error) {
+	// Only the latest result of each system, ties are broken by the ID to get a single result per system
+	reconcileResults := make([]*dbmodels.ReconcileResult, 0)
+	err := r.db.WithContext(ctx).
+		Preload("System").
+		Preload("Team").
+		Where("team_id = ?", obj.ID).
+		Where(`NOT EXISTS (SELECT 1 FROM reconcile_results AS newer
+WHERE newer.team_id = reconcile_results.team_id
+  AND newer.system_id = reconcile_results.system_id
+  AND (newer.updated_at > reconcile_results.updated_at OR (newer.updated_at = reconcile_results.updated_at AND newer.id > reconcile_results.id)))`).
+		Order("updated_at DESC").
+		Find(&reconcileResults).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return reconcileResults, nil
+}
+
 // Team returns generated.TeamResolver implementation.
 func (r *Resolver) Team() generated.TeamResolver { return &teamResolver{r} }
 
@@ -2,17 +2,37 @@ package graph_test
 
 import (
 	"context"
+	"fmt"
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/authz"
+	helpers "github.com/nais/console/pkg/console"
 	"github.com/nais/console/pkg/graph"
 	"github.com/nais/console/pkg/graph/model"
+	"github.com/nais/console/pkg/notifications"
 	"github.com/nais/console/pkg/reconcilers"
+	console_reconciler "github.com/nais/console/pkg/reconcilers/console"
+	github_team_reconciler "github.com/nais/console/pkg/reconcilers/github/team"
+	"github.com/nais/console/pkg/roles"
 	"github.com/nais/console/pkg/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/nais/console/pkg/dbmodels"
 )
 
+func assertCounts(t *testing.T, pageInfo *model.PageInfo, results, offset int) {
+	actualResults, err := pageInfo.Results(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, results, actualResults)
+
+	actualOffset, err := pageInfo.Offset(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, offset, actualOffset)
+}
+
 func getSystem() *dbmodels.System {
 	systemId, _ := uuid.NewUUID()
 	system := &dbmodels.System{
@@ -45,10 +65,10 @@ func TestQueryResolver_Teams(t *testing.T) {
 	system := getSystem()
 
 	ctx := context.Background()
-	resolver := graph.NewResolver(db, "example.com", system, ch, nil).Query()
+	resolver := graph.NewResolver(db, "example.com", system, ch, nil, nil, nil).Query()
 
 	t.Run("No filter or sort", func(t *testing.T) {
-		teams, err := resolver.Teams(ctx, nil, nil, nil)
+		teams, err := resolver.Teams(ctx, nil, nil, nil, nil, nil)
 		assert.NoError(t, err)
 
 		assert.Len(t, teams.Nodes, 3)
@@ -58,7 +78,7 @@ func TestQueryResolver_Teams(t *testing.T) {
 	})
 
 	t.Run("Sort name DESC", func(t *testing.T) {
-		teams, err := resolver.Teams(ctx, nil, nil, &model.TeamsSort{
+		teams, err := resolver.Teams(ctx, nil, nil, nil, nil, &model.TeamsSort{
 			Field:     model.TeamSortFieldName,
 			Direction: model.SortDirectionDesc,
 		})
@@ -69,4 +89,301 @@ func TestQueryResolver_Teams(t *testing.T) {
 		assert.Equal(t, "b", teams.Nodes[1].Slug.String())
 		assert.Equal(t, "a", teams.Nodes[2].Slug.String())
 	})
+	t.Run("Cursor pagination", func(t *testing.T) {
+		first := 2
+		teams, err := resolver.Teams(ctx, nil, &first, nil, nil, nil)
+		assert.NoError(t, err)
+
+		assert.Len(t, teams.Edges, 2)
+		assert.Equal(t, "a", teams.Edges[0].Node.Slug.String())
+		assert.Equal(t, "b", teams.Edges[1].Node.Slug.String())
+		assert.True(t, teams.PageInfo.HasNextPage)
+		assert.Equal(t, teams.Edges[1].Cursor, *teams.PageInfo.EndCursor)
+		assertCounts(t, teams.PageInfo, 3, 0)
+		assert.Equal(t, 2, teams.PageInfo.Limit)
+
+		teams, err = resolver.Teams(ctx, nil, &first, teams.PageInfo.EndCursor, nil, nil)
+		assert.NoError(t, err)
+
+		assert.Len(t, teams.Nodes, 1)
+		assert.Equal(t, "c", teams.Nodes[0].Slug.String())
+		assert.False(t, teams.PageInfo.HasNextPage)
+		assertCounts(t, teams.PageInfo, 3, 2)
+	})
+
+	t.Run("Page size is limited", func(t *testing.T) {
+		for _, first := range []int{0, 101} {
+			_, err := resolver.Teams(ctx, nil, &first, nil, nil, nil)
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("Cursor pagination sorted by name DESC", func(t *testing.T) {
+		first := 1
+		sort := &model.TeamsSort{
+			Field:     model.TeamSortFieldName,
+			Direction: model.SortDirectionDesc,
+		}
+		slugs := make([]string, 0)
+		var after *string
+		for {
+			teams, err := resolver.Teams(ctx, nil, &first, after, nil, sort)
+			assert.NoError(t, err)
+			for _, team := range teams.Nodes {
+				slugs = append(slugs, team.Slug.String())
+			}
+			if !teams.PageInfo.HasNextPage {
+				break
+			}
+			after = teams.PageInfo.EndCursor
+		}
+
+		assert.Equal(t, []string{"c", "b", "a"}, slugs)
+	})
+
+	t.Run("Cursor pagination sorted by creation time", func(t *testing.T) {
+		first := 2
+		sort := &model.TeamsSort{
+			Field:     model.TeamSortFieldCreatedAt,
+			Direction: model.SortDirectionAsc,
+		}
+		teams, err := resolver.Teams(ctx, nil, &first, nil, nil, sort)
+		assert.NoError(t, err)
+		assert.Len(t, teams.Nodes, 2)
+
+		rest, err := resolver.Teams(ctx, nil, &first, teams.PageInfo.EndCursor, nil, sort)
+		assert.NoError(t, err)
+		assert.Len(t, rest.Nodes, 1)
+		assert.NotContains(t, teams.Nodes, rest.Nodes[0])
+	})
+
+	t.Run("Offset pagination", func(t *testing.T) {
+		teams, err := resolver.Teams(ctx, &model.Pagination{Offset: 1, Limit: 1}, nil, nil, nil, nil)
+		assert.NoError(t, err)
+
+		assert.Len(t, teams.Nodes, 1)
+		assert.Equal(t, "b", teams.Nodes[0].Slug.String())
+		assertCounts(t, teams.PageInfo, 3, 1)
+		assert.True(t, teams.PageInfo.HasNextPage)
+		assert.Len(t, teams.Edges, 1)
+	})
+
+	t.Run("Invalid cursor", func(t *testing.T) {
+		after := "invalid"
+		_, err := resolver.Teams(ctx, nil, nil, &after, nil, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		search := "B"
+		teams, err := resolver.Teams(ctx, nil, nil, nil, &model.TeamsQuery{Search: &search}, nil)
+		assert.NoError(t, err)
+
+		assert.Len(t, teams.Nodes, 1)
+		assert.Equal(t, "b", teams.Nodes[0].Slug.String())
+	})
+
+	t.Run("Search combined with slug filter", func(t *testing.T) {
+		search := "a"
+		slug := dbmodels.Slug("c")
+		teams, err := resolver.Teams(ctx, nil, nil, nil, &model.TeamsQuery{Search: &search, Slug: &slug}, nil)
+		assert.NoError(t, err)
+		assert.Len(t, teams.Nodes, 0)
+	})
+
+	t.Run("Offset and cursor pagination combined", func(t *testing.T) {
+		first := 1
+		_, err := resolver.Teams(ctx, &model.Pagination{Offset: 0, Limit: 1}, &first, nil, nil, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestMutationResolver_SetTeamMetadata(t *testing.T) {
+	db := test.GetTestDB()
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Team{}, &dbmodels.TeamMetadata{}, &dbmodels.System{}, &dbmodels.Correlation{})
+
+	team := &dbmodels.Team{Slug: "team", Name: "Team"}
+	db.Create(team)
+
+	system := getSystem()
+	auditLogger := &auditlogger.MockAuditLogger{}
+	auditLogger.On("Logf", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	resolver := graph.NewResolver(db, "example.com", system, make(chan reconcilers.Input, 100), auditLogger, nil, nil).Mutation()
+
+	owner := &dbmodels.User{RoleBindings: []dbmodels.UserRole{{
+		Role:     dbmodels.Role{Authorizations: []dbmodels.Authorization{{Name: string(roles.AuthorizationTeamsUpdate)}}},
+		TargetID: team.ID,
+	}}}
+	ctx := authz.ContextWithUser(context.Background(), owner)
+	value := "https://203.0.113.10/hooks/team"
+
+	t.Run("Not authorized", func(t *testing.T) {
+		ctx := authz.ContextWithUser(context.Background(), &dbmodels.User{})
+		_, err := resolver.SetTeamMetadata(ctx, team.ID, notifications.MetadataSlackWebhook, &value)
+		assert.ErrorIs(t, err, authz.ErrNotAuthorized)
+	})
+
+	t.Run("Parent team requires administrator", func(t *testing.T) {
+		parent := "nais-teams"
+		_, err := resolver.SetTeamMetadata(ctx, team.ID, github_team_reconciler.MetadataParentTeam, &parent)
+		assert.ErrorIs(t, err, authz.ErrNotAuthorized)
+	})
+
+	t.Run("Webhook must be a public https URL", func(t *testing.T) {
+		for _, webhook := range []string{"http://203.0.113.10/hooks/team", "https://169.254.169.254/computeMetadata/v1/"} {
+			_, err := resolver.SetTeamMetadata(ctx, team.ID, notifications.MetadataWebhook, &webhook)
+			assert.Error(t, err)
+		}
+
+		var count int64
+		db.Model(&dbmodels.TeamMetadata{}).Where("team_id = ?", team.ID).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("Set and clear", func(t *testing.T) {
+		_, err := resolver.SetTeamMetadata(ctx, team.ID, notifications.MetadataSlackWebhook, &value)
+		assert.NoError(t, err)
+
+		metadata := make([]*dbmodels.TeamMetadata, 0)
+		db.Where("team_id = ?", team.ID).Find(&metadata)
+		assert.Len(t, metadata, 1)
+		assert.Equal(t, value, *metadata[0].Value)
+
+		_, err = resolver.SetTeamMetadata(ctx, team.ID, notifications.MetadataSlackWebhook, nil)
+		assert.NoError(t, err)
+
+		var count int64
+		db.Model(&dbmodels.TeamMetadata{}).Where("team_id = ?", team.ID).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+}
+
+func TestMutationResolver_ResolveGitHubTeamConflict(t *testing.T) {
+	db := test.GetTestDB()
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Team{}, &dbmodels.TeamMetadata{}, &dbmodels.System{}, &dbmodels.SystemState{}, &dbmodels.Correlation{})
+
+	team := &dbmodels.Team{Slug: "team", Name: "Team"}
+	github := &dbmodels.System{Name: github_team_reconciler.Name}
+	db.Create(team)
+	db.Create(github)
+
+	auditLogger := &auditlogger.MockAuditLogger{}
+	teamReconciler := make(chan reconcilers.Input, 100)
+	resolver := graph.NewResolver(db, "example.com", getSystem(), teamReconciler, auditLogger, nil, nil).Mutation()
+
+	admin := &dbmodels.User{RoleBindings: []dbmodels.UserRole{{
+		Role: dbmodels.Role{Authorizations: []dbmodels.Authorization{{Name: string(roles.AuthorizationSystemStatesUpdate)}}},
+	}}}
+	ctx := authz.ContextWithUser(context.Background(), admin)
+
+	state := func() *reconcilers.GitHubState {
+		state := &reconcilers.GitHubState{}
+		dbmodels.LoadSystemState(db, *github.ID, *team.ID, state)
+		return state
+	}
+
+	t.Run("Not authorized", func(t *testing.T) {
+		owner := &dbmodels.User{RoleBindings: []dbmodels.UserRole{{
+			Role:     dbmodels.Role{Authorizations: []dbmodels.Authorization{{Name: string(roles.AuthorizationTeamsUpdate)}}},
+			TargetID: team.ID,
+		}}}
+		ctx := authz.ContextWithUser(context.Background(), owner)
+		_, err := resolver.ResolveGitHubTeamConflict(ctx, team.ID, model.GitHubTeamConflictResolutionAdopt, nil)
+		assert.ErrorIs(t, err, authz.ErrNotAuthorized)
+	})
+
+	t.Run("Alternate name", func(t *testing.T) {
+		_, err := resolver.ResolveGitHubTeamConflict(ctx, team.ID, model.GitHubTeamConflictResolutionAlternateName, nil)
+		assert.Error(t, err)
+
+		name := "team-console"
+		details := console_reconciler.GitHubTeamConflictDetails{Resolution: string(model.GitHubTeamConflictResolutionAlternateName), Name: name}
+		auditLogger.On("LogWithDetails", console_reconciler.OpResolveGitHubTeamConflict, mock.Anything, mock.Anything, admin, mock.Anything, mock.Anything, details, mock.Anything, mock.Anything).Return(nil).Once()
+		_, err = resolver.ResolveGitHubTeamConflict(ctx, team.ID, model.GitHubTeamConflictResolutionAlternateName, &name)
+		assert.NoError(t, err)
+		assert.Equal(t, name, *state().Name)
+		assert.Nil(t, state().Slug)
+		assert.Len(t, teamReconciler, 1)
+	})
+
+	t.Run("Adopt", func(t *testing.T) {
+		details := console_reconciler.GitHubTeamConflictDetails{Resolution: string(model.GitHubTeamConflictResolutionAdopt), Name: "team"}
+		auditLogger.On("LogWithDetails", console_reconciler.OpResolveGitHubTeamConflict, mock.Anything, mock.Anything, admin, mock.Anything, mock.Anything, details, mock.Anything, mock.Anything).Return(nil).Once()
+		_, err := resolver.ResolveGitHubTeamConflict(ctx, team.ID, model.GitHubTeamConflictResolutionAdopt, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "team", *state().Slug)
+		assert.Nil(t, state().Name)
+	})
+
+	t.Run("Already connected", func(t *testing.T) {
+		githubTeamID := int64(1)
+		dbmodels.SetSystemState(db, *github.ID, *team.ID, reconcilers.GitHubState{Slug: helpers.Strp("team"), ID: &githubTeamID})
+		_, err := resolver.ResolveGitHubTeamConflict(ctx, team.ID, model.GitHubTeamConflictResolutionAdopt, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestTeamResolver_ReconcileResults(t *testing.T) {
+	db := test.GetTestDB()
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Team{}, &dbmodels.System{}, &dbmodels.Correlation{}, &dbmodels.ReconcileResult{})
+
+	team := &dbmodels.Team{Slug: "team", Name: "Team"}
+	github := &dbmodels.System{Name: github_team_reconciler.Name}
+	console := &dbmodels.System{Name: console_reconciler.Name}
+	first := &dbmodels.Correlation{}
+	second := &dbmodels.Correlation{}
+	db.Create(team)
+	db.Create(github)
+	db.Create(console)
+	db.Create(first)
+	db.Create(second)
+
+	assert.NoError(t, dbmodels.SetReconcileResult(db, *first.ID, *github.ID, *team.ID, nil))
+	assert.NoError(t, dbmodels.SetReconcileResult(db, *first.ID, *console.ID, *team.ID, nil))
+	time.Sleep(10 * time.Millisecond)
+	assert.NoError(t, dbmodels.SetReconcileResult(db, *second.ID, *github.ID, *team.ID, fmt.Errorf("%w: team exists", dbmodels.ErrReconcileConflict)))
+
+	resolver := graph.NewResolver(db, "example.com", getSystem(), make(chan reconcilers.Input), nil, nil, nil).Team()
+	results, err := resolver.ReconcileResults(context.Background(), team)
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, dbmodels.ReconcileStatusConflict, results[0].Status)
+	assert.Equal(t, github_team_reconciler.Name, results[0].System.Name)
+	assert.Equal(t, dbmodels.ReconcileStatusSucceeded, results[1].Status)
+	assert.Equal(t, console_reconciler.Name, results[1].System.Name)
+}
+
+func TestTeamResolver_Metadata(t *testing.T) {
+	db := test.GetTestDB()
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Team{}, &dbmodels.TeamMetadata{})
+
+	team := &dbmodels.Team{Slug: "team", Name: "Team"}
+	db.Create(team)
+
+	channel := "#team"
+	webhook := "https://203.0.113.10/hooks/team"
+	db.Create(&dbmodels.TeamMetadata{TeamID: *team.ID, Key: "slack-channel-generic", Value: &channel})
+	db.Create(&dbmodels.TeamMetadata{TeamID: *team.ID, Key: notifications.MetadataWebhook, Value: &webhook})
+
+	resolver := graph.NewResolver(db, "example.com", getSystem(), make(chan reconcilers.Input), nil, nil, nil).Team()
+
+	t.Run("Webhooks are hidden from users who can not update the team", func(t *testing.T) {
+		ctx := authz.ContextWithUser(context.Background(), &dbmodels.User{})
+		metadata, err := resolver.Metadata(ctx, team)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"slack-channel-generic": &channel}, metadata)
+	})
+
+	t.Run("Webhooks are shown to team owners", func(t *testing.T) {
+		owner := &dbmodels.User{RoleBindings: []dbmodels.UserRole{{
+			Role:     dbmodels.Role{Authorizations: []dbmodels.Authorization{{Name: string(roles.AuthorizationTeamsUpdate)}}},
+			TargetID: team.ID,
+		}}}
+		metadata, err := resolver.Metadata(authz.ContextWithUser(context.Background(), owner), team)
+		assert.NoError(t, err)
+		assert.Len(t, metadata, 2)
+		assert.Equal(t, &webhook, metadata[notifications.MetadataWebhook])
+	})
 }
@@ -5,8 +5,9 @@ package graph
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/nais/console/pkg/authz"
@@ -15,9 +16,12 @@ import (
 	"github.com/nais/console/pkg/fixtures"
 	"github.com/nais/console/pkg/graph/generated"
 	"github.com/nais/console/pkg/graph/model"
-	"gorm.io/gorm"
 )
 
+func (r *externalIdentityResolver) LastSyncedAt(ctx context.Context, obj *dbmodels.ExternalIdentity) (*time.Time, error) {
+	return &obj.UpdatedAt, nil
+}
+
 func (r *mutationResolver) CreateServiceAccount(ctx context.Context, input model.CreateServiceAccountInput) (*dbmodels.User, error) {
 	sa := &dbmodels.User{
 		Name:  input.Name.String(),
@@ -71,7 +75,7 @@ func (r *mutationResolver) DeleteServiceAccount(ctx context.Context, serviceAcco
 	return true, nil
 }
 
-func (r *queryResolver) Users(ctx context.Context, pagination *model.Pagination, query *model.UsersQuery, sort *model.UsersSort) (*model.Users, error) {
+func (r *queryResolver) Users(ctx context.Context, pagination *model.Pagination, first *int, after *string, query *model.UsersQuery, sort *model.UsersSort) (*model.Users, error) {
 	users := make([]*dbmodels.User, 0)
 
 	if sort == nil {
@@ -80,12 +84,24 @@ func (r *queryResolver) Users(ctx context.Context, pagination *model.Pagination,
 			Direction: model.SortDirectionAsc,
 		}
 	}
-	pageInfo, err := r.paginatedQuery(pagination, query, sort, &dbmodels.User{}, &users)
+	pageInfo, cursors, err := r.paginatedQuery(ctx, pagination, first, after, query, sort, &dbmodels.User{}, &users)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*model.UserEdge, len(users))
+	for i := range users {
+		edges[i] = &model.UserEdge{
+			Cursor: cursors[i],
+			Node:   users[i],
+		}
+	}
 
 	return &model.Users{
 		PageInfo: pageInfo,
 		Nodes:    users,
-	}, err
+		Edges:    edges,
+	}, nil
 }
 
 func (r *queryResolver) User(ctx context.Context, id *uuid.UUID) (*dbmodels.User, error) {
@@ -102,32 +118,28 @@ func (r *queryResolver) Me(ctx context.Context) (*dbmodels.User, error) {
 }
 
 func (r *userResolver) Teams(ctx context.Context, obj *dbmodels.User) ([]*dbmodels.Team, error) {
-	teams := make([]*dbmodels.Team, 0)
-	err := r.db.Model(obj).Association("Teams").Find(&teams)
-	if err != nil {
-		return nil, err
-	}
-	return teams, nil
+	return r.loaders(ctx).UserTeams.Load(ctx, *obj.ID)
 }
 
 func (r *userResolver) HasAPIKey(ctx context.Context, obj *dbmodels.User) (bool, error) {
-	apiKey := &dbmodels.ApiKey{}
-	err := r.db.Where("user_id = ?", obj.ID).First(&apiKey).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return false, nil
-		}
-		return false, err
-	}
-
-	return true, nil
+	return r.loaders(ctx).HasAPIKey.Load(ctx, *obj.ID)
 }
 
 func (r *userResolver) IsServiceAccount(ctx context.Context, obj *dbmodels.User) (bool, error) {
 	return console.IsServiceAccount(*obj, r.tenantDomain), nil
 }
 
+func (r *userResolver) ExternalIdentities(ctx context.Context, obj *dbmodels.User) ([]*dbmodels.ExternalIdentity, error) {
+	return r.loaders(ctx).ExternalIdentities.Load(ctx, strings.ToLower(obj.Email))
+}
+
+// ExternalIdentity returns generated.ExternalIdentityResolver implementation.
+func (r *Resolver) ExternalIdentity() generated.ExternalIdentityResolver {
+	return &externalIdentityResolver{r}
+}
+
 // User returns generated.UserResolver implementation.
 func (r *Resolver) User() generated.UserResolver { return &userResolver{r} }
 
+type externalIdentityResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
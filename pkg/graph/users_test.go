@@ -0,0 +1,40 @@
+package graph_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/graph"
+	"github.com/nais/console/pkg/reconcilers"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserResolver_ExternalIdentities(t *testing.T) {
+	db := test.GetTestDB()
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.System{}, &dbmodels.ExternalIdentity{})
+
+	github := &dbmodels.System{Name: "github:team"}
+	user := &dbmodels.User{Email: "User@example.com", Name: "User"}
+	otherUser := &dbmodels.User{Email: "other@example.com", Name: "Other user"}
+	db.Create(github)
+	db.Create(user)
+	db.Create(otherUser)
+	db.Create(&dbmodels.ExternalIdentity{SystemID: *github.ID, Email: "user@example.com", Username: "user-login"})
+
+	resolver := graph.NewResolver(db, "example.com", getSystem(), make(chan reconcilers.Input), nil, nil, nil).User()
+	ctx := context.Background()
+
+	identities, err := resolver.ExternalIdentities(ctx, user)
+	assert.NoError(t, err)
+	assert.Len(t, identities, 1)
+	assert.Equal(t, "user-login", identities[0].Username)
+	assert.Equal(t, "github:team", identities[0].System.Name)
+
+	identities, err = resolver.ExternalIdentities(ctx, otherUser)
+	assert.NoError(t, err)
+	assert.Empty(t, identities)
+}
@@ -0,0 +1,61 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nais/console/pkg/dbmodels"
+	"gorm.io/gorm"
+)
+
+// Database A check that fails if the database can not be reached
+func Database(db *gorm.DB) CheckFunc {
+	return func(ctx context.Context) (string, error) {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return "", err
+		}
+
+		err = sqlDB.PingContext(ctx)
+		if err != nil {
+			return "", fmt.Errorf("ping database: %w", err)
+		}
+
+		stats := sqlDB.Stats()
+		return fmt.Sprintf("%d open connections, %d in use", stats.OpenConnections, stats.InUse), nil
+	}
+}
+
+// Migrations A check that fails if the database schema is older than the most recent migration known to this replica.
+// A newer schema is expected while replicas are being upgraded.
+func Migrations(db *gorm.DB) CheckFunc {
+	return func(ctx context.Context) (string, error) {
+		migrator, err := dbmodels.NewMigrator(db.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+
+		version, err := migrator.Version()
+		if err != nil {
+			return "", fmt.Errorf("get schema version: %w", err)
+		}
+
+		message := fmt.Sprintf("schema version %d", version)
+		if latest := migrator.LatestVersion(); version < latest {
+			return message, fmt.Errorf("schema version is %d, expected at least %d", version, latest)
+		}
+
+		return message, nil
+	}
+}
+
+// Credentials A check that fails if the credentials of an external system are not accepted
+func Credentials(fn func(ctx context.Context) error) CheckFunc {
+	return func(ctx context.Context) (string, error) {
+		err := fn(ctx)
+		if err != nil {
+			return "", err
+		}
+		return "credentials accepted", nil
+	}
+}
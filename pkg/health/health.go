@@ -0,0 +1,193 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Status Outcome of a check, or of all checks combined
+type Status string
+
+const (
+	StatusOK       Status = "ok"
+	StatusDegraded Status = "degraded" // A non-critical check failed
+	StatusFailing  Status = "failing"  // A critical check failed
+)
+
+// CheckFunc Check a single component. The returned message describes the state of the component, and is included in
+// the report whether the check fails or not.
+type CheckFunc func(ctx context.Context) (message string, err error)
+
+// ComponentReport Outcome of the check of a single component
+type ComponentReport struct {
+	Status    Status    `json:"status"`
+	Critical  bool      `json:"critical"`
+	Message   string    `json:"message,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+// Report Outcome of all checks
+type Report struct {
+	Status     Status                     `json:"status"`
+	Components map[string]ComponentReport `json:"components"`
+}
+
+type check struct {
+	name     string
+	critical bool
+	fn       CheckFunc
+}
+
+type Checker struct {
+	checks  []check
+	timeout time.Duration
+}
+
+// New Create a checker. Each check is given at most timeout to complete.
+func New(timeout time.Duration) *Checker {
+	return &Checker{
+		checks:  make([]check, 0),
+		timeout: timeout,
+	}
+}
+
+// Add Register a check. Critical checks decide whether this replica is ready to serve requests. Must not be called
+// after the checker has started serving requests.
+func (c *Checker) Add(name string, critical bool, fn CheckFunc) {
+	c.checks = append(c.checks, check{
+		name:     name,
+		critical: critical,
+		fn:       fn,
+	})
+}
+
+// Run Run checks concurrently. If criticalOnly is set, only the critical checks are run.
+func (c *Checker) Run(ctx context.Context, criticalOnly bool) Report {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	report := Report{
+		Status:     StatusOK,
+		Components: make(map[string]ComponentReport),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, chk := range c.checks {
+		if criticalOnly && !chk.critical {
+			continue
+		}
+
+		wg.Add(1)
+		go func(chk check) {
+			defer wg.Done()
+			component := runCheck(ctx, chk)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Components[chk.name] = component
+		}(chk)
+	}
+	wg.Wait()
+
+	for _, component := range report.Components {
+		switch {
+		case component.Status == StatusOK:
+		case component.Critical:
+			report.Status = StatusFailing
+		case report.Status == StatusOK:
+			report.Status = StatusDegraded
+		}
+	}
+
+	return report
+}
+
+// runCheck Run a single check, giving up when ctx is done
+func runCheck(ctx context.Context, chk check) ComponentReport {
+	type result struct {
+		message string
+		err     error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		message, err := chk.fn(ctx)
+		done <- result{message: message, err: err}
+	}()
+
+	component := ComponentReport{
+		Status:   StatusOK,
+		Critical: chk.critical,
+	}
+
+	var res result
+	select {
+	case res = <-done:
+	case <-ctx.Done():
+		res.err = ctx.Err()
+	}
+
+	component.CheckedAt = time.Now()
+	component.Message = res.message
+	if res.err != nil {
+		component.Status = StatusFailing
+		component.Error = res.err.Error()
+	}
+
+	return component
+}
+
+// ReadinessHandler Serve the outcome of the critical checks. Responds with 503 if any of them fail.
+func (c *Checker) ReadinessHandler() http.HandlerFunc {
+	return c.handler(true)
+}
+
+// DetailsHandler Serve the outcome of all checks. Responds with 503 if a critical check fails; failing non-critical
+// checks only degrade the status.
+func (c *Checker) DetailsHandler() http.HandlerFunc {
+	return c.handler(false)
+}
+
+func (c *Checker) handler(criticalOnly bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := c.Run(r.Context(), criticalOnly)
+
+		w.Header().Set("Content-Type", "application/json")
+		if report.Status == StatusFailing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		err := json.NewEncoder(w).Encode(report)
+		if err != nil {
+			log.Warnf("unable to write health report: %s", err)
+		}
+	}
+}
+
+// Cached Wrap a check so it is run at most once per ttl. Use for checks that are expensive or call external systems.
+func Cached(fn CheckFunc, ttl time.Duration) CheckFunc {
+	var mu sync.Mutex
+	var checkedAt time.Time
+	var message string
+	var err error
+
+	return func(ctx context.Context) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if time.Since(checkedAt) < ttl {
+			return message, err
+		}
+
+		message, err = fn(ctx)
+		checkedAt = time.Now()
+		return message, err
+	}
+}
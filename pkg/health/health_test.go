@@ -0,0 +1,176 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/health"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func ok(message string) health.CheckFunc {
+	return func(_ context.Context) (string, error) {
+		return message, nil
+	}
+}
+
+func failing(message string) health.CheckFunc {
+	return func(_ context.Context) (string, error) {
+		return "", errors.New(message)
+	}
+}
+
+func TestChecker_Run(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("all checks pass", func(t *testing.T) {
+		checker := health.New(time.Second)
+		checker.Add("database", true, ok("connected"))
+		checker.Add("usersync", false, ok("synced"))
+
+		report := checker.Run(ctx, false)
+		assert.Equal(t, health.StatusOK, report.Status)
+		assert.Len(t, report.Components, 2)
+		assert.Equal(t, "connected", report.Components["database"].Message)
+		assert.True(t, report.Components["database"].Critical)
+	})
+
+	t.Run("non-critical check fails", func(t *testing.T) {
+		checker := health.New(time.Second)
+		checker.Add("database", true, ok("connected"))
+		checker.Add("usersync", false, failing("not synced"))
+
+		report := checker.Run(ctx, false)
+		assert.Equal(t, health.StatusDegraded, report.Status)
+		assert.Equal(t, health.StatusFailing, report.Components["usersync"].Status)
+		assert.Equal(t, "not synced", report.Components["usersync"].Error)
+
+		report = checker.Run(ctx, true)
+		assert.Equal(t, health.StatusOK, report.Status)
+		assert.Len(t, report.Components, 1)
+	})
+
+	t.Run("critical check fails", func(t *testing.T) {
+		checker := health.New(time.Second)
+		checker.Add("database", true, failing("connection refused"))
+		checker.Add("usersync", false, failing("not synced"))
+
+		report := checker.Run(ctx, false)
+		assert.Equal(t, health.StatusFailing, report.Status)
+	})
+
+	t.Run("check times out", func(t *testing.T) {
+		checker := health.New(10 * time.Millisecond)
+		checker.Add("database", true, func(ctx context.Context) (string, error) {
+			time.Sleep(time.Second)
+			return "too late", nil
+		})
+
+		report := checker.Run(ctx, false)
+		assert.Equal(t, health.StatusFailing, report.Status)
+		assert.Equal(t, context.DeadlineExceeded.Error(), report.Components["database"].Error)
+	})
+}
+
+func TestChecker_Handlers(t *testing.T) {
+	checker := health.New(time.Second)
+	checker.Add("database", true, ok("connected"))
+	checker.Add("usersync", false, failing("not synced"))
+
+	rec := httptest.NewRecorder()
+	checker.ReadinessHandler()(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	rec = httptest.NewRecorder()
+	checker.DetailsHandler()(rec, httptest.NewRequest(http.MethodGet, "/healthz/details", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	report := health.Report{}
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&report))
+	assert.Equal(t, health.StatusDegraded, report.Status)
+	assert.Equal(t, health.StatusFailing, report.Components["usersync"].Status)
+
+	checker.Add("migrations", true, failing("schema version is 1, expected at least 2"))
+	rec = httptest.NewRecorder()
+	checker.ReadinessHandler()(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestCached(t *testing.T) {
+	calls := 0
+	check := health.Cached(func(_ context.Context) (string, error) {
+		calls++
+		return "", errors.New("invalid client secret")
+	}, time.Hour)
+
+	for i := 0; i < 3; i++ {
+		_, err := check(context.Background())
+		assert.EqualError(t, err, "invalid client secret")
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestHeartbeat(t *testing.T) {
+	ctx := context.Background()
+	heartbeat := health.NewHeartbeat()
+	check := heartbeat.Check(50 * time.Millisecond)
+
+	message, err := check(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "not running on this replica", message)
+
+	heartbeat.Start()
+	_, err = check(ctx)
+	assert.NoError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+	_, err = check(ctx)
+	assert.Error(t, err)
+
+	heartbeat.Beat()
+	_, err = check(ctx)
+	assert.NoError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+	heartbeat.Stop()
+	_, err = check(ctx)
+	assert.NoError(t, err)
+}
+
+func TestMigrations(t *testing.T) {
+	ctx := context.Background()
+	db := test.GetTestDB()
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	db.AutoMigrate(&dbmodels.SchemaMigration{})
+
+	migrator, err := dbmodels.NewMigrator(db)
+	assert.NoError(t, err)
+	latest := migrator.LatestVersion()
+
+	message, err := health.Database(db)(ctx)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, message)
+
+	for version := 1; version < latest; version++ {
+		db.Create(&dbmodels.SchemaMigration{Version: version, Name: "migration", AppliedAt: time.Now()})
+	}
+	_, err = health.Migrations(db)(ctx)
+	assert.Error(t, err)
+
+	db.Create(&dbmodels.SchemaMigration{Version: latest, Name: "migration", AppliedAt: time.Now()})
+	_, err = health.Migrations(db)(ctx)
+	assert.NoError(t, err)
+
+	db.Create(&dbmodels.SchemaMigration{Version: latest + 1, Name: "migration", AppliedAt: time.Now()})
+	_, err = health.Migrations(db)(ctx)
+	assert.NoError(t, err)
+}
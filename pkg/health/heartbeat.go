@@ -0,0 +1,64 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Heartbeat Records when a recurring job last completed, so it can be checked for liveness. A heartbeat is either
+// running or stopped; stopped heartbeats are jobs that are not supposed to run on this replica, and always pass.
+type Heartbeat struct {
+	mu      sync.Mutex
+	running bool
+	last    time.Time
+	now     func() time.Time
+}
+
+func NewHeartbeat() *Heartbeat {
+	return &Heartbeat{
+		now: time.Now,
+	}
+}
+
+// Start Mark the job as running. The job is given until maxAge from now to beat for the first time.
+func (h *Heartbeat) Start() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.running = true
+	h.last = h.now()
+}
+
+// Stop Mark the job as not running on this replica
+func (h *Heartbeat) Stop() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.running = false
+}
+
+// Beat Record that the job completed successfully
+func (h *Heartbeat) Beat() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.last = h.now()
+}
+
+// Check A check that fails if the job is running, and has not beat within maxAge
+func (h *Heartbeat) Check(maxAge time.Duration) CheckFunc {
+	return func(_ context.Context) (string, error) {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		if !h.running {
+			return "not running on this replica", nil
+		}
+
+		message := fmt.Sprintf("last completed at %s", h.last.Format(time.RFC3339))
+		if age := h.now().Sub(h.last); age > maxAge {
+			return message, fmt.Errorf("not completed for %s, expected at most %s", age.Round(time.Second), maxAge)
+		}
+
+		return message, nil
+	}
+}
@@ -0,0 +1,102 @@
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/nais/console/pkg/dbmodels"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// elector Elect a single leader among Console replicas sharing a database. The leader holds a session level advisory
+// lock on a dedicated database connection, so leadership is released by PostgreSQL if the leader dies or loses its
+// connection. Databases other than PostgreSQL have no replicas, and the process is always the leader.
+type elector struct {
+	db   *gorm.DB
+	conn *sql.Conn
+}
+
+func New(db *gorm.DB) *elector {
+	return &elector{
+		db: db,
+	}
+}
+
+// Campaign Try to become the leader, or check that leadership is still held. Must be called periodically, and not
+// concurrently. Returns false with an error if leadership was lost because the connection holding the lock failed.
+func (e *elector) Campaign(ctx context.Context) (bool, error) {
+	if e.db.Dialector.Name() != "postgres" {
+		return true, nil
+	}
+
+	if e.conn != nil {
+		// The lock is held for as long as the session lives
+		err := e.conn.PingContext(ctx)
+		if err == nil {
+			return true, nil
+		}
+
+		discard(e.conn)
+		e.conn = nil
+		return false, fmt.Errorf("lost connection holding the leader lock: %w", err)
+	}
+
+	sqlDB, err := e.db.DB()
+	if err != nil {
+		return false, err
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("get connection for the leader lock: %w", err)
+	}
+
+	var acquired bool
+	err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", dbmodels.LeaderLockID).Scan(&acquired)
+	if err != nil || !acquired {
+		conn.Close()
+		if err != nil {
+			return false, fmt.Errorf("acquire leader lock: %w", err)
+		}
+		return false, nil
+	}
+
+	e.conn = conn
+	return true, nil
+}
+
+// Resign Release leadership, if held, so another replica can take over without waiting for this process to exit
+func (e *elector) Resign(ctx context.Context) error {
+	if e.conn == nil {
+		return nil
+	}
+
+	defer func() {
+		discard(e.conn)
+		e.conn = nil
+	}()
+
+	var released bool
+	err := e.conn.QueryRowContext(ctx, "SELECT pg_advisory_unlock($1)", dbmodels.LeaderLockID).Scan(&released)
+	if err != nil {
+		return fmt.Errorf("release leader lock: %w", err)
+	}
+
+	if !released {
+		log.Warnf("The leader lock was not held when resigning")
+	}
+
+	return nil
+}
+
+// discard Close the connection instead of returning it to the pool, which ends the session and releases any lock held
+// by it
+func discard(conn *sql.Conn) {
+	conn.Raw(func(interface{}) error {
+		return driver.ErrBadConn
+	})
+	conn.Close()
+}
@@ -0,0 +1,81 @@
+package leader_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/leader"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestElector_WithoutPostgres(t *testing.T) {
+	ctx := context.Background()
+	elector := leader.New(test.GetTestDB())
+
+	// Only PostgreSQL supports several replicas, so any other database always has a leader
+	leading, err := elector.Campaign(ctx)
+	assert.NoError(t, err)
+	assert.True(t, leading)
+
+	assert.NoError(t, elector.Resign(ctx))
+}
+
+func TestElector_WithPostgres(t *testing.T) {
+	ctx := context.Background()
+	db := test.GetPostgresTestDB(t)
+	assert.NoError(t, dbmodels.Migrate(db))
+
+	system := &dbmodels.System{Name: "console"}
+	corr := &dbmodels.Correlation{}
+	assert.NoError(t, db.Create(system).Error)
+	assert.NoError(t, db.Create(corr).Error)
+
+	elector := leader.New(db)
+	leading, err := elector.Campaign(ctx)
+	assert.NoError(t, err)
+	assert.True(t, leading)
+
+	t.Run("Only one leader", func(t *testing.T) {
+		leading, err := leader.New(db).Campaign(ctx)
+		assert.NoError(t, err)
+		assert.False(t, leading)
+	})
+
+	t.Run("Leader lock does not block other locks", func(t *testing.T) {
+		done := make(chan error, 1)
+		go func() {
+			err := auditlogger.New(db).Logf("action", *corr, *system, nil, nil, nil, "written while a leader exists")
+			if err != nil {
+				done <- err
+				return
+			}
+			_, err = auditlogger.SealLegacyEntries(db)
+			if err != nil {
+				done <- err
+				return
+			}
+			done <- dbmodels.Migrate(db)
+		}()
+
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("blocked by the leader lock")
+		}
+	})
+
+	assert.NoError(t, elector.Resign(ctx))
+
+	t.Run("Leadership is released when resigning", func(t *testing.T) {
+		other := leader.New(db)
+		leading, err := other.Campaign(ctx)
+		assert.NoError(t, err)
+		assert.True(t, leading)
+		assert.NoError(t, other.Resign(ctx))
+	})
+}
@@ -0,0 +1,117 @@
+package legacy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/roles"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Import Create teams from the legacy team files, and populate them with members and owners from the Azure AD group
+// of each team. Users outside the tenant domain are skipped. Teams that already exist are updated in place.
+func Import(db *gorm.DB, gimp *GroupImporter, teams map[string]*Team, domain string) ([]*dbmodels.Team, error) {
+	dbteams := make([]*dbmodels.Team, 0, len(teams))
+	suffix := "@" + domain
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		teamOwner := &dbmodels.Role{}
+		err := tx.Where("name = ?", roles.RoleTeamOwner).First(teamOwner).Error
+		if err != nil {
+			return fmt.Errorf("find team owner role: %w", err)
+		}
+
+		for _, yamlteam := range teams {
+			team := yamlteam.Convert()
+			metadata := team.Metadata
+			team.Metadata = nil
+
+			err = tx.Where("slug = ?", team.Slug).FirstOrCreate(team).Error
+			if err != nil {
+				return fmt.Errorf("create team '%s': %w", team.Slug, err)
+			}
+
+			for _, meta := range metadata {
+				meta.TeamID = *team.ID
+				err = tx.Where("team_id = ? AND key = ?", team.ID, meta.Key).Assign(dbmodels.TeamMetadata{Value: meta.Value}).FirstOrCreate(meta).Error
+				if err != nil {
+					return fmt.Errorf("set metadata '%s' for team '%s': %w", meta.Key, team.Slug, err)
+				}
+			}
+
+			log.Debugf("Fetch team info for %s...", team.Slug)
+			members, err := gimp.GroupMembers(yamlteam.AzureID)
+			if err != nil {
+				return err
+			}
+
+			validMembers := make([]*dbmodels.User, 0, len(members))
+			for _, member := range members {
+				member.Email = strings.ToLower(member.Email)
+				if !strings.HasSuffix(member.Email, suffix) {
+					log.Warnf("Skip member %s", member.Email)
+					continue
+				}
+				err = tx.Where("email = ?", member.Email).FirstOrCreate(member).Error
+				if err != nil {
+					return err
+				}
+				validMembers = append(validMembers, member)
+			}
+
+			log.Debugf("Fetch team administrators for %s...", team.Slug)
+			owners, err := gimp.GroupOwners(yamlteam.AzureID)
+			if err != nil {
+				return err
+			}
+
+			numOwners := 0
+			for _, owner := range owners {
+				owner.Email = strings.ToLower(owner.Email)
+				if !strings.HasSuffix(owner.Email, suffix) {
+					log.Warnf("Skip owner %s", owner.Email)
+					continue
+				}
+				err = tx.Where("email = ?", owner.Email).FirstOrCreate(owner).Error
+				if err != nil {
+					return err
+				}
+				err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&dbmodels.UserRole{
+					RoleID:   *teamOwner.ID,
+					UserID:   *owner.ID,
+					TargetID: team.ID,
+				}).Error
+				if err != nil {
+					return err
+				}
+				validMembers = append(validMembers, owner)
+				numOwners++
+			}
+
+			for _, user := range validMembers {
+				err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&dbmodels.UserTeam{
+					UserID: *user.ID,
+					TeamID: *team.ID,
+				}).Error
+				if err != nil {
+					return fmt.Errorf("add user '%s' to team '%s': %w", user.Email, team.Slug, err)
+				}
+			}
+
+			log.Infof("Imported %s with %d owners and %d members", team.Slug, numOwners, len(validMembers)-numOwners)
+
+			dbteams = append(dbteams, team)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return dbteams, nil
+}
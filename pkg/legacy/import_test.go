@@ -0,0 +1,122 @@
+package legacy_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nais/console/pkg/azureclient"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/legacy"
+	"github.com/nais/console/pkg/roles"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+func writeFile(t *testing.T, name, contents string) string {
+	path := filepath.Join(t.TempDir(), name)
+	err := os.WriteFile(path, []byte(contents), 0o600)
+	assert.NoError(t, err)
+	return path
+}
+
+func TestReadTeamFiles(t *testing.T) {
+	ymlPath := writeFile(t, "teams.yml", `teams:
+  - name: team-a
+    description: Team A
+    slack-channel: "#team-a"
+    platform-alerts-channel: "#team-a-alerts"
+  - name: team-b
+    description: Team B
+`)
+
+	t.Run("Azure IDs are merged into the teams", func(t *testing.T) {
+		jsonPath := writeFile(t, "teams.json", `{"azure-id-a": "team-a", "azure-id-unknown": "team-unknown"}`)
+
+		teams, err := legacy.ReadTeamFiles(ymlPath, jsonPath)
+		assert.NoError(t, err)
+		assert.Len(t, teams, 2)
+
+		assert.Equal(t, &legacy.Team{
+			AzureID:               "azure-id-a",
+			Name:                  "team-a",
+			Description:           "Team A",
+			SlackChannel:          "#team-a",
+			PlatformAlertsChannel: "#team-a-alerts",
+		}, teams["team-a"])
+
+		// Teams without a mapping are kept without an Azure ID, and mappings without a team are skipped
+		assert.Equal(t, "", teams["team-b"].AzureID)
+		assert.NotContains(t, teams, "team-unknown")
+	})
+
+	t.Run("Invalid files", func(t *testing.T) {
+		jsonPath := writeFile(t, "teams.json", `["not", "a", "map"]`)
+		_, err := legacy.ReadTeamFiles(ymlPath, jsonPath)
+		assert.Error(t, err)
+
+		_, err = legacy.ReadTeamFiles(filepath.Join(t.TempDir(), "missing.yml"), jsonPath)
+		assert.Error(t, err)
+	})
+}
+
+func TestImport(t *testing.T) {
+	db := test.GetTestDB()
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Team{}, &dbmodels.TeamMetadata{}, &dbmodels.Authorization{}, &dbmodels.Role{}, &dbmodels.UserRole{}, &dbmodels.UserTeam{})
+
+	teamOwner := &dbmodels.Role{Name: string(roles.RoleTeamOwner)}
+	db.Create(teamOwner)
+
+	client := azureclient.NewMockClient(t)
+	client.
+		On("ListGroupMembers", mock.Anything, &azureclient.Group{ID: "azure-id-a"}).
+		Return([]*azureclient.Member{{Mail: "Member@Example.com"}, {Mail: "external@example.org"}}, nil)
+	client.
+		On("ListGroupOwners", mock.Anything, &azureclient.Group{ID: "azure-id-a"}).
+		Return([]*azureclient.Owner{{UserPrincipalName: "owner@example.com"}, {UserPrincipalName: "owner@example.org"}}, nil)
+	gimp := legacy.New(clientcredentials.Config{}, client)
+
+	teams := map[string]*legacy.Team{
+		"team-a": {
+			AzureID:      "azure-id-a",
+			Name:         "team-a",
+			Description:  "Team A",
+			SlackChannel: "#team-a",
+		},
+	}
+
+	// Importing again updates the existing team in place
+	for i := 0; i < 2; i++ {
+		imported, err := legacy.Import(db, gimp, teams, "example.com")
+		assert.NoError(t, err)
+		assert.Len(t, imported, 1)
+	}
+
+	team := &dbmodels.Team{}
+	err := db.Preload("Users").Preload("Metadata").Where("slug = ?", "team-a").First(team).Error
+	assert.NoError(t, err)
+	assert.Equal(t, "Team A", *team.Purpose)
+
+	assert.Len(t, team.Metadata, 1)
+	assert.Equal(t, "slack-channel-generic", team.Metadata[0].Key)
+	assert.Equal(t, "#team-a", *team.Metadata[0].Value)
+
+	emails := make([]string, 0)
+	for _, user := range team.Users {
+		emails = append(emails, user.Email)
+	}
+	assert.ElementsMatch(t, []string{"member@example.com", "owner@example.com"}, emails)
+
+	owners := make([]*dbmodels.UserRole, 0)
+	db.Preload("User").Where("role_id = ? AND target_id = ?", teamOwner.ID, team.ID).Find(&owners)
+	assert.Len(t, owners, 1)
+	assert.Equal(t, "owner@example.com", owners[0].User.Email)
+
+	var users int64
+	db.Model(&dbmodels.User{}).Count(&users)
+	assert.Equal(t, int64(2), users)
+}
@@ -14,22 +14,31 @@ import (
 func ApiKeyAuthentication(db *gorm.DB) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		fn := func(w http.ResponseWriter, r *http.Request) {
-			authHeader := r.Header.Get("authorization")
-			if !strings.HasPrefix(authHeader, "Bearer ") || len(authHeader) < 8 {
+			user := userFromAuthorizationHeader(db, r.Header.Get("authorization"))
+			if user == nil {
 				next.ServeHTTP(w, r)
 				return
 			}
 
-			key := &dbmodels.ApiKey{}
-			err := db.Preload("User").Where("api_key = ?", authHeader[7:]).First(key).Error
-			if err != nil {
-				next.ServeHTTP(w, r)
-				return
-			}
-
-			ctx := authz.ContextWithUser(r.Context(), &key.User)
+			ctx := authz.ContextWithUser(r.Context(), user)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		}
 		return http.HandlerFunc(fn)
 	}
 }
+
+// userFromAuthorizationHeader Get the owner of the API key in a bearer token authorization header. Returns nil if the
+// header is malformed or the API key does not exist.
+func userFromAuthorizationHeader(db *gorm.DB, authHeader string) *dbmodels.User {
+	if !strings.HasPrefix(authHeader, "Bearer ") || len(authHeader) < 8 {
+		return nil
+	}
+
+	key := &dbmodels.ApiKey{}
+	err := db.Preload("User").Where("api_key = ?", authHeader[7:]).First(key).Error
+	if err != nil {
+		return nil
+	}
+
+	return &key.User
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/nais/console/pkg/dataloader"
+	"gorm.io/gorm"
+)
+
+// DataLoaders Attach a fresh set of data loaders to each request, so objects are only fetched once per request.
+// Websocket connections are skipped, as the loaders would otherwise cache objects for the lifetime of the connection.
+func DataLoaders(db *gorm.DB) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Upgrade") != "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := dataloader.ContextWithLoaders(r.Context(), dataloader.NewLoaders(r.Context(), db))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		}
+		return http.HandlerFunc(fn)
+	}
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"github.com/nais/console/pkg/authz"
+	"github.com/nais/console/pkg/dbmodels"
 	"gorm.io/gorm"
 	"net/http"
 )
@@ -17,12 +18,7 @@ func LoadUserRoles(db *gorm.DB) func(next http.Handler) http.Handler {
 				return
 			}
 
-			err := db.
-				Model(user).
-				Preload("Role").
-				Preload("Role.Authorizations").
-				Association("RoleBindings").
-				Find(&user.RoleBindings)
+			err := loadUserRoles(db, user)
 			if err != nil {
 				next.ServeHTTP(w, r)
 				return
@@ -34,3 +30,12 @@ func LoadUserRoles(db *gorm.DB) func(next http.Handler) http.Handler {
 		return http.HandlerFunc(fn)
 	}
 }
+
+func loadUserRoles(db *gorm.DB, user *dbmodels.User) error {
+	return db.
+		Model(user).
+		Preload("Role").
+		Preload("Role.Authorizations").
+		Association("RoleBindings").
+		Find(&user.RoleBindings)
+}
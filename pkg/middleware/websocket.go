@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/nais/console/pkg/authz"
+	"gorm.io/gorm"
+)
+
+// WebsocketInit Authenticate websocket connections. Browsers are authenticated by the session cookie sent along with
+// the upgrade request, while other clients can't set headers on websocket connections, and must instead put the API
+// key in the authorization field of the connection init payload.
+func WebsocketInit(db *gorm.DB) transport.WebsocketInitFunc {
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, error) {
+		if authz.UserFromContext(ctx) != nil {
+			return ctx, nil
+		}
+
+		authHeader := payload.Authorization()
+		if authHeader == "" {
+			return ctx, nil
+		}
+
+		user := userFromAuthorizationHeader(db, authHeader)
+		if user == nil {
+			return nil, fmt.Errorf("invalid API key")
+		}
+
+		err := loadUserRoles(db, user)
+		if err != nil {
+			return nil, err
+		}
+
+		return authz.ContextWithUser(ctx, user), nil
+	}
+}
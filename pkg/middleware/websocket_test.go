@@ -0,0 +1,48 @@
+package middleware_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/nais/console/pkg/authz"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/middleware"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebsocketInit(t *testing.T) {
+	db := test.GetTestDB()
+	db.AutoMigrate(&dbmodels.ApiKey{}, &dbmodels.User{}, &dbmodels.Role{}, &dbmodels.UserRole{})
+	user1 := &dbmodels.User{Email: "user1@example.com"}
+	user2 := &dbmodels.User{Email: "user2@example.com"}
+	db.Create([]*dbmodels.User{user1, user2})
+	db.Create(&dbmodels.ApiKey{APIKey: "user1-key", UserID: *user1.ID})
+
+	init := middleware.WebsocketInit(db)
+
+	t.Run("No authorization in payload", func(t *testing.T) {
+		ctx, err := init(context.Background(), transport.InitPayload{})
+		assert.NoError(t, err)
+		assert.Nil(t, authz.UserFromContext(ctx))
+	})
+
+	t.Run("Unknown API key in payload", func(t *testing.T) {
+		_, err := init(context.Background(), transport.InitPayload{"authorization": "Bearer unknown-key"})
+		assert.Error(t, err)
+	})
+
+	t.Run("Valid API key in payload", func(t *testing.T) {
+		ctx, err := init(context.Background(), transport.InitPayload{"Authorization": "Bearer user1-key"})
+		assert.NoError(t, err)
+		assert.Equal(t, "user1@example.com", authz.UserFromContext(ctx).Email)
+	})
+
+	t.Run("User authenticated by the upgrade request", func(t *testing.T) {
+		ctx := authz.ContextWithUser(context.Background(), user2)
+		ctx, err := init(ctx, transport.InitPayload{"Authorization": "Bearer user1-key"})
+		assert.NoError(t, err)
+		assert.Equal(t, "user2@example.com", authz.UserFromContext(ctx).Email)
+	})
+}
@@ -0,0 +1,62 @@
+package notifications
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/nais/console/pkg/dbmodels"
+)
+
+// message Data available to the notification templates
+type message struct {
+	Event    Event
+	Team     dbmodels.Team
+	System   *dbmodels.System
+	Actor    *dbmodels.User
+	Error    string
+	Failures int
+	Added    []*dbmodels.User
+	Removed  []*dbmodels.User
+}
+
+var templateFuncs = template.FuncMap{
+	"names": func(users []*dbmodels.User) string {
+		names := make([]string, len(users))
+		for i, user := range users {
+			names[i] = user.Name + " (" + user.Email + ")"
+		}
+		return strings.Join(names, ", ")
+	},
+}
+
+var templates = map[Event]*template.Template{
+	EventReconcileFailed: template.Must(template.New(string(EventReconcileFailed)).Funcs(templateFuncs).Parse(
+		`Synchronization of team {{ .Team.Slug }} with {{ .System.Name }} has failed {{ .Failures }} times in a row: {{ .Error }}`,
+	)),
+	EventMembershipChanged: template.Must(template.New(string(EventMembershipChanged)).Funcs(templateFuncs).Parse(
+		`{{ if .Actor }}{{ .Actor.Name }}{{ else }}Console{{ end }} changed the members of team {{ .Team.Slug }}.` +
+			`{{ if .Added }} Added: {{ names .Added }}.{{ end }}` +
+			`{{ if .Removed }} Removed: {{ names .Removed }}.{{ end }}`,
+	)),
+	EventTeamDeleted: template.Must(template.New(string(EventTeamDeleted)).Funcs(templateFuncs).Parse(
+		`Team {{ .Team.Slug }} has been deleted{{ if .Actor }} by {{ .Actor.Name }}{{ end }}.`,
+	)),
+}
+
+// render Get the human readable text of the message
+func (m message) render() (string, error) {
+	tpl, exists := templates[m.Event]
+	if !exists {
+		return "", fmt.Errorf("no template for notification event '%s'", m.Event)
+	}
+
+	buf := &bytes.Buffer{}
+	err := tpl.Execute(buf, m)
+	if err != nil {
+		return "", fmt.Errorf("render %s notification: %w", m.Event, err)
+	}
+
+	return buf.String(), nil
+}
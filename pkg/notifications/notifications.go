@@ -0,0 +1,296 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/config"
+	"github.com/nais/console/pkg/dbmodels"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Team metadata keys holding the webhooks notifications are posted to
+const (
+	MetadataSlackWebhook = "notifications-slack-webhook"
+	MetadataWebhook      = "notifications-webhook"
+)
+
+// Event The kind of notification
+type Event string
+
+const (
+	EventReconcileFailed   Event = "reconcile_failed"
+	EventMembershipChanged Event = "membership_changed"
+	EventTeamDeleted       Event = "team_deleted"
+)
+
+var (
+	ErrNotEnabled = errors.New("disabled by configuration")
+)
+
+// Notifier Notify teams about events that concern them. Teams opt in by setting a webhook in the team metadata.
+// Notifications are posted in the background, so a slow webhook does not hold up the caller.
+type Notifier interface {
+	// ReconcileFailed Register a failed reconcile of a team. The team is notified once the reconciler has failed a
+	// number of times in a row.
+	ReconcileFailed(ctx context.Context, team dbmodels.Team, system dbmodels.System, reconcileErr error) error
+
+	// ReconcileSucceeded Register a successful reconcile of a team, so a later failure is notified again
+	ReconcileSucceeded(ctx context.Context, team dbmodels.Team, system dbmodels.System) error
+
+	// MembershipChanged Notify a team that members have been added or removed
+	MembershipChanged(ctx context.Context, corr dbmodels.Correlation, team dbmodels.Team, actor *dbmodels.User, added, removed []*dbmodels.User) error
+
+	// TeamDeleted Notify a team that it has been deleted
+	TeamDeleted(ctx context.Context, team dbmodels.Team, actor *dbmodels.User) error
+
+	// Wait Wait for notifications that are being posted to finish
+	Wait()
+}
+
+type notifier struct {
+	db               *gorm.DB
+	sender           *sender
+	failureThreshold int
+	dedupWindow      time.Duration
+	now              func() time.Time
+	pending          sync.WaitGroup
+}
+
+// New Create a notifier posting to webhooks with the given client. Use NewWebhookClient unless testing.
+func New(db *gorm.DB, client *http.Client, webhookSecret string, failureThreshold int, dedupWindow time.Duration) Notifier {
+	return &notifier{
+		db:               db,
+		sender:           newSender(client, webhookSecret),
+		failureThreshold: failureThreshold,
+		dedupWindow:      dedupWindow,
+		now:              time.Now,
+	}
+}
+
+func NewFromConfig(cfg *config.Config, db *gorm.DB) (Notifier, error) {
+	if !cfg.Notifications.Enabled {
+		return nil, ErrNotEnabled
+	}
+
+	if cfg.Notifications.FailureThreshold < 1 {
+		return nil, fmt.Errorf("the failure threshold for notifications must be at least 1")
+	}
+
+	return New(db, NewWebhookClient(), cfg.Notifications.WebhookSecret, cfg.Notifications.FailureThreshold, cfg.Notifications.DedupWindow), nil
+}
+
+func (n *notifier) ReconcileFailed(ctx context.Context, team dbmodels.Team, system dbmodels.System, reconcileErr error) error {
+	key := failureKey(system)
+	failures, err := n.countFailure(ctx, *team.ID, key)
+	if err != nil {
+		return fmt.Errorf("count reconcile failures: %w", err)
+	}
+
+	if failures < n.failureThreshold {
+		return nil
+	}
+
+	return n.notify(ctx, team, key, message{
+		Event:    EventReconcileFailed,
+		Team:     team,
+		System:   &system,
+		Error:    reconcileErr.Error(),
+		Failures: failures,
+	})
+}
+
+func (n *notifier) ReconcileSucceeded(ctx context.Context, team dbmodels.Team, system dbmodels.System) error {
+	return n.db.WithContext(ctx).
+		Where("team_id = ? AND key = ?", team.ID, failureKey(system)).
+		Delete(&dbmodels.Notification{}).
+		Error
+}
+
+func (n *notifier) MembershipChanged(ctx context.Context, corr dbmodels.Correlation, team dbmodels.Team, actor *dbmodels.User, added, removed []*dbmodels.User) error {
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	return n.notify(ctx, team, string(EventMembershipChanged)+":"+corr.ID.String(), message{
+		Event:   EventMembershipChanged,
+		Team:    team,
+		Actor:   actor,
+		Added:   added,
+		Removed: removed,
+	})
+}
+
+func (n *notifier) TeamDeleted(ctx context.Context, team dbmodels.Team, actor *dbmodels.User) error {
+	return n.notify(ctx, team, string(EventTeamDeleted), message{
+		Event: EventTeamDeleted,
+		Team:  team,
+		Actor: actor,
+	})
+}
+
+func (n *notifier) Wait() {
+	n.pending.Wait()
+}
+
+// notify Send a notification to the webhooks configured for the team, unless a notification with the same key has
+// been sent within the de-duplication window
+func (n *notifier) notify(ctx context.Context, team dbmodels.Team, key string, msg message) error {
+	webhooks, err := n.webhooks(ctx, *team.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	text, err := msg.render()
+	if err != nil {
+		return err
+	}
+
+	claimed, err := n.claim(ctx, *team.ID, key)
+	if err != nil {
+		return fmt.Errorf("de-duplicate notification: %w", err)
+	}
+
+	if !claimed {
+		return nil
+	}
+
+	n.pending.Add(1)
+	go func() {
+		defer n.pending.Done()
+		n.post(team, key, webhooks, msg, text)
+	}()
+
+	return nil
+}
+
+// post Post a claimed notification to the webhooks of the team. If it could not be posted to any of them, the claim is
+// released so the notification is sent the next time instead of being held back for the de-duplication window.
+func (n *notifier) post(team dbmodels.Team, key string, webhooks map[string]string, msg message, text string) {
+	ctx := context.Background()
+
+	sent := 0
+	for kind, url := range webhooks {
+		err := n.sender.send(ctx, kind, url, msg, text)
+		if err != nil {
+			log.Warnf("unable to send %s notification to team '%s': %s", msg.Event, team.Slug, err)
+			continue
+		}
+		sent++
+	}
+
+	if sent > 0 {
+		return
+	}
+
+	err := n.release(ctx, *team.ID, key)
+	if err != nil {
+		log.Errorf("unable to release %s notification to team '%s': %s", msg.Event, team.Slug, err)
+	}
+}
+
+// webhooks Get the webhooks configured for the team, keyed by the metadata key
+func (n *notifier) webhooks(ctx context.Context, teamID uuid.UUID) (map[string]string, error) {
+	metadata := make([]*dbmodels.TeamMetadata, 0)
+	err := n.db.WithContext(ctx).Where("team_id = ? AND key IN (?)", teamID, []string{MetadataSlackWebhook, MetadataWebhook}).Find(&metadata).Error
+	if err != nil {
+		return nil, fmt.Errorf("get notification webhooks: %w", err)
+	}
+
+	webhooks := make(map[string]string)
+	for _, entry := range metadata {
+		if entry.Value != nil && *entry.Value != "" {
+			webhooks[entry.Key] = *entry.Value
+		}
+	}
+
+	return webhooks, nil
+}
+
+// claim Record that a notification is about to be sent. Returns false if a notification with the same key has been
+// sent to the team within the de-duplication window. Safe to use from several replicas at once.
+func (n *notifier) claim(ctx context.Context, teamID uuid.UUID, key string) (bool, error) {
+	db := n.db.WithContext(ctx)
+	now := n.now()
+
+	res := db.Model(&dbmodels.Notification{}).
+		Where("team_id = ? AND key = ? AND (sent_at IS NULL OR sent_at < ?)", teamID, key, now.Add(-n.dedupWindow)).
+		UpdateColumn("sent_at", now)
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	if res.RowsAffected > 0 {
+		return true, nil
+	}
+
+	res = db.Clauses(clause.OnConflict{DoNothing: true}).Create(&dbmodels.Notification{
+		Model: dbmodels.Model{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		TeamID: teamID,
+		Key:    key,
+		SentAt: &now,
+	})
+
+	return res.RowsAffected > 0, res.Error
+}
+
+// release Undo a claim for a notification that could not be sent
+func (n *notifier) release(ctx context.Context, teamID uuid.UUID, key string) error {
+	return n.db.WithContext(ctx).
+		Model(&dbmodels.Notification{}).
+		Where("team_id = ? AND key = ?", teamID, key).
+		UpdateColumn("sent_at", nil).
+		Error
+}
+
+// countFailure Add a failed reconcile to the count kept in the notification, and return the number of failures in a
+// row. The count is kept in the database, so it is shared between replicas and survives restarts.
+func (n *notifier) countFailure(ctx context.Context, teamID uuid.UUID, key string) (int, error) {
+	db := n.db.WithContext(ctx)
+	now := n.now()
+
+	err := db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "team_id"}, {Name: "key"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"failures":   gorm.Expr("notifications.failures + 1"),
+			"updated_at": now,
+		}),
+	}).Create(&dbmodels.Notification{
+		Model: dbmodels.Model{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		TeamID:   teamID,
+		Key:      key,
+		Failures: 1,
+	}).Error
+	if err != nil {
+		return 0, err
+	}
+
+	notification := &dbmodels.Notification{}
+	err = db.Where("team_id = ? AND key = ?", teamID, key).First(notification).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return notification.Failures, nil
+}
+
+func failureKey(system dbmodels.System) string {
+	return string(EventReconcileFailed) + ":" + system.Name
+}
@@ -0,0 +1,193 @@
+package notifications_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/notifications"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type receiver struct {
+	lock     sync.Mutex
+	bodies   []map[string]interface{}
+	headers  []http.Header
+	response int
+}
+
+func newReceiver(t *testing.T) (*receiver, *httptest.Server) {
+	rcv := &receiver{response: http.StatusOK}
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := make(map[string]interface{})
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		rcv.lock.Lock()
+		defer rcv.lock.Unlock()
+		rcv.bodies = append(rcv.bodies, body)
+		rcv.headers = append(rcv.headers, r.Header)
+		w.WriteHeader(rcv.response)
+	}))
+	t.Cleanup(srv.Close)
+	return rcv, srv
+}
+
+func (r *receiver) received() []map[string]interface{} {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.bodies
+}
+
+func setup(metadata map[string]string) (*gorm.DB, dbmodels.Team, dbmodels.System) {
+	db := test.GetTestDB()
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Team{}, &dbmodels.TeamMetadata{}, &dbmodels.System{}, &dbmodels.Notification{})
+
+	team := dbmodels.Team{Slug: "team", Name: "Team"}
+	system := dbmodels.System{Name: "github:team"}
+	db.Create(&team)
+	db.Create(&system)
+
+	for key, value := range metadata {
+		value := value
+		db.Create(&dbmodels.TeamMetadata{TeamID: *team.ID, Key: key, Value: &value})
+	}
+
+	return db, team, system
+}
+
+func TestReconcileFailed(t *testing.T) {
+	ctx := context.Background()
+	reconcileErr := errors.New("rate limited")
+
+	t.Run("Notify persistent failures once", func(t *testing.T) {
+		rcv, srv := newReceiver(t)
+		db, team, system := setup(map[string]string{notifications.MetadataSlackWebhook: srv.URL})
+		notifier := notifications.New(db, srv.Client(), "", 3, time.Hour)
+
+		for i := 0; i < 2; i++ {
+			assert.NoError(t, notifier.ReconcileFailed(ctx, team, system, reconcileErr))
+		}
+		notifier.Wait()
+		assert.Empty(t, rcv.received())
+
+		assert.NoError(t, notifier.ReconcileFailed(ctx, team, system, reconcileErr))
+		notifier.Wait()
+		assert.Len(t, rcv.received(), 1)
+		assert.Equal(t, "Synchronization of team team with github:team has failed 3 times in a row: rate limited", rcv.received()[0]["text"])
+
+		assert.NoError(t, notifier.ReconcileFailed(ctx, team, system, reconcileErr))
+		notifier.Wait()
+		assert.Len(t, rcv.received(), 1)
+	})
+
+	t.Run("Notify again after the reconciler has recovered", func(t *testing.T) {
+		rcv, srv := newReceiver(t)
+		db, team, system := setup(map[string]string{notifications.MetadataSlackWebhook: srv.URL})
+		notifier := notifications.New(db, srv.Client(), "", 1, time.Hour)
+
+		assert.NoError(t, notifier.ReconcileFailed(ctx, team, system, reconcileErr))
+		assert.NoError(t, notifier.ReconcileSucceeded(ctx, team, system))
+		assert.NoError(t, notifier.ReconcileFailed(ctx, team, system, reconcileErr))
+		notifier.Wait()
+		assert.Len(t, rcv.received(), 2)
+	})
+
+	t.Run("Failures and notifications are shared between notifiers", func(t *testing.T) {
+		rcv, srv := newReceiver(t)
+		db, team, system := setup(map[string]string{notifications.MetadataSlackWebhook: srv.URL})
+
+		for i := 0; i < 3; i++ {
+			notifier := notifications.New(db, srv.Client(), "", 2, time.Hour)
+			assert.NoError(t, notifier.ReconcileFailed(ctx, team, system, reconcileErr))
+			notifier.Wait()
+		}
+		assert.Len(t, rcv.received(), 1)
+		assert.Equal(t, "Synchronization of team team with github:team has failed 2 times in a row: rate limited", rcv.received()[0]["text"])
+	})
+
+	t.Run("No webhook configured", func(t *testing.T) {
+		db, team, system := setup(nil)
+		notifier := notifications.New(db, http.DefaultClient, "", 1, time.Hour)
+
+		assert.NoError(t, notifier.ReconcileFailed(ctx, team, system, reconcileErr))
+
+		notification := &dbmodels.Notification{}
+		assert.NoError(t, db.First(notification).Error)
+		assert.Equal(t, 1, notification.Failures)
+		assert.Nil(t, notification.SentAt)
+	})
+
+	t.Run("Webhook error releases the notification", func(t *testing.T) {
+		rcv, srv := newReceiver(t)
+		rcv.response = http.StatusInternalServerError
+		db, team, system := setup(map[string]string{notifications.MetadataSlackWebhook: srv.URL})
+		notifier := notifications.New(db, srv.Client(), "", 1, time.Hour)
+
+		assert.NoError(t, notifier.ReconcileFailed(ctx, team, system, reconcileErr))
+		notifier.Wait()
+		assert.NoError(t, notifier.ReconcileFailed(ctx, team, system, reconcileErr))
+		notifier.Wait()
+		assert.Len(t, rcv.received(), 2)
+	})
+
+	t.Run("Webhook without https", func(t *testing.T) {
+		db, team, system := setup(map[string]string{notifications.MetadataSlackWebhook: "http://203.0.113.10/hooks"})
+		notifier := notifications.New(db, http.DefaultClient, "", 1, time.Hour)
+
+		assert.NoError(t, notifier.ReconcileFailed(ctx, team, system, reconcileErr))
+		notifier.Wait()
+
+		notification := &dbmodels.Notification{}
+		assert.NoError(t, db.First(notification).Error)
+		assert.Nil(t, notification.SentAt)
+	})
+}
+
+func TestMembershipChanged(t *testing.T) {
+	ctx := context.Background()
+	rcv, srv := newReceiver(t)
+	db, team, _ := setup(map[string]string{notifications.MetadataWebhook: srv.URL})
+	notifier := notifications.New(db, srv.Client(), "secret", 1, time.Hour)
+
+	actor := &dbmodels.User{Name: "Actor", Email: "actor@example.com"}
+	added := []*dbmodels.User{{Name: "User", Email: "user@example.com"}}
+	corr := dbmodels.Correlation{}
+	db.AutoMigrate(&dbmodels.Correlation{})
+	db.Create(&corr)
+
+	assert.NoError(t, notifier.MembershipChanged(ctx, corr, team, actor, added, nil))
+	notifier.Wait()
+	assert.NoError(t, notifier.MembershipChanged(ctx, corr, team, actor, added, nil))
+	assert.NoError(t, notifier.MembershipChanged(ctx, corr, team, actor, nil, nil))
+	notifier.Wait()
+
+	assert.Len(t, rcv.received(), 1)
+	body := rcv.received()[0]
+	assert.Equal(t, "membership_changed", body["event"])
+	assert.Equal(t, "team", body["team"])
+	assert.Equal(t, "Actor changed the members of team team. Added: User (user@example.com).", body["message"])
+	assert.Equal(t, []interface{}{"user@example.com"}, body["added"])
+	assert.Contains(t, rcv.headers[0].Get(auditlogger.SignatureHeader), "sha256=")
+}
+
+func TestTeamDeleted(t *testing.T) {
+	rcv, srv := newReceiver(t)
+	db, team, _ := setup(map[string]string{notifications.MetadataSlackWebhook: srv.URL})
+	notifier := notifications.New(db, srv.Client(), "", 1, time.Hour)
+
+	assert.NoError(t, notifier.TeamDeleted(context.Background(), team, &dbmodels.User{Name: "Actor"}))
+	notifier.Wait()
+	assert.Len(t, rcv.received(), 1)
+	assert.Equal(t, "Team team has been deleted by Actor.", rcv.received()[0]["text"])
+}
@@ -0,0 +1,114 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/dbmodels"
+)
+
+const webhookTimeout = 10 * time.Second
+
+// slackPayload Body posted to Slack compatible incoming webhooks
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+// webhookPayload Body posted to generic webhooks
+type webhookPayload struct {
+	Event   Event    `json:"event"`
+	Team    string   `json:"team"`
+	System  string   `json:"system,omitempty"`
+	Message string   `json:"message"`
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+type sender struct {
+	secret []byte
+	client *http.Client
+}
+
+func newSender(client *http.Client, secret string) *sender {
+	return &sender{
+		secret: []byte(secret),
+		client: client,
+	}
+}
+
+// send Post the message to a webhook. kind is the metadata key the webhook was configured with, and decides the format
+// of the body.
+func (s *sender) send(ctx context.Context, kind, webhook string, msg message, text string) error {
+	u, err := url.Parse(webhook)
+	if err != nil {
+		return fmt.Errorf("invalid %s URL: %w", kind, err)
+	}
+
+	// Webhooks can be written without going through the API, for instance by the legacy import
+	if u.Scheme != "https" {
+		return fmt.Errorf("%s URL must use https", kind)
+	}
+
+	var payload interface{}
+	switch kind {
+	case MetadataSlackWebhook:
+		payload = slackPayload{Text: text}
+	default:
+		p := webhookPayload{
+			Event:   msg.Event,
+			Team:    string(msg.Team.Slug),
+			Message: text,
+			Added:   emails(msg.Added),
+			Removed: emails(msg.Removed),
+		}
+		if msg.System != nil {
+			p.System = msg.System.Name
+		}
+		payload = p
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if kind != MetadataSlackWebhook && len(s.secret) > 0 {
+		req.Header.Set(auditlogger.SignatureHeader, auditlogger.Sign(s.secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s responded with status %s", kind, resp.Status)
+	}
+
+	return nil
+}
+
+func emails(users []*dbmodels.User) []string {
+	if len(users) == 0 {
+		return nil
+	}
+
+	emails := make([]string, len(users))
+	for i, user := range users {
+		emails[i] = user.Email
+	}
+	return emails
+}
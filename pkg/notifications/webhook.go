@@ -0,0 +1,101 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+)
+
+// IsWebhook Check if the team metadata key holds a webhook notifications are posted to
+func IsWebhook(key string) bool {
+	return key == MetadataSlackWebhook || key == MetadataWebhook
+}
+
+// ValidateWebhookURL Make sure a webhook URL set by a team uses HTTPS, and that the host does not resolve to a
+// private, loopback, link-local or unspecified address. Teams set webhooks themselves, so Console must not be usable to
+// reach services that are only available from within the cluster.
+func ValidateWebhookURL(ctx context.Context, webhook string) error {
+	u, err := url.Parse(webhook)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %w", err)
+	}
+
+	if u.Scheme != "https" {
+		return fmt.Errorf("webhook URL must use https")
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		return fmt.Errorf("webhook URL must have a host")
+	}
+
+	ips := make([]net.IP, 0)
+	if ip := net.ParseIP(host); ip != nil {
+		ips = append(ips, ip)
+	} else {
+		addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return fmt.Errorf("unable to resolve webhook host '%s': %w", host, err)
+		}
+		for _, addr := range addrs {
+			ips = append(ips, addr.IP)
+		}
+	}
+
+	for _, ip := range ips {
+		if !isPublic(ip) {
+			return fmt.Errorf("webhook host '%s' resolves to a non-public address", host)
+		}
+	}
+
+	return nil
+}
+
+func isPublic(ip net.IP) bool {
+	return !ip.IsPrivate() &&
+		!ip.IsLoopback() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsUnspecified()
+}
+
+// NewWebhookClient Create the HTTP client notifications are posted with. The address is checked when connecting, so
+// webhooks that were never validated, or hosts that resolve to another address than when they were validated, can not
+// be used to reach services within the cluster. Redirects are not followed for the same reason.
+func NewWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: dialControl,
+	}
+
+	return &http.Client{
+		Timeout: webhookTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			TLSHandshakeTimeout: webhookTimeout,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// dialControl Refuse to connect to addresses that are not public
+func dialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublic(ip) {
+		return fmt.Errorf("webhook address '%s' is not public", host)
+	}
+
+	return nil
+}
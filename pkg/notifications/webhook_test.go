@@ -0,0 +1,67 @@
+package notifications_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nais/console/pkg/notifications"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateWebhookURL(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("public address", func(t *testing.T) {
+		assert.NoError(t, notifications.ValidateWebhookURL(ctx, "https://203.0.113.10/hooks/team"))
+	})
+
+	t.Run("not https", func(t *testing.T) {
+		assert.ErrorContains(t, notifications.ValidateWebhookURL(ctx, "http://203.0.113.10/hooks/team"), "must use https")
+		assert.ErrorContains(t, notifications.ValidateWebhookURL(ctx, "gopher://203.0.113.10"), "must use https")
+	})
+
+	t.Run("non-public addresses", func(t *testing.T) {
+		for _, webhook := range []string{
+			"https://localhost/hooks",
+			"https://127.0.0.1/hooks",
+			"https://10.0.0.1/hooks",
+			"https://192.168.1.1:8443/hooks",
+			"https://169.254.169.254/computeMetadata/v1/",
+			"https://0.0.0.0/hooks",
+			"https://[::1]/hooks",
+			"https://[fe80::1]/hooks",
+			"https://[fd00::1]/hooks",
+			"https://[::ffff:10.0.0.1]/hooks",
+		} {
+			assert.ErrorContains(t, notifications.ValidateWebhookURL(ctx, webhook), "non-public address", webhook)
+		}
+	})
+
+	t.Run("missing host", func(t *testing.T) {
+		assert.ErrorContains(t, notifications.ValidateWebhookURL(ctx, "https:///hooks"), "must have a host")
+	})
+}
+
+func TestNewWebhookClient(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "https://169.254.169.254/computeMetadata/v1/", http.StatusFound)
+	}))
+	defer srv.Close()
+
+	t.Run("non-public address", func(t *testing.T) {
+		_, err := notifications.NewWebhookClient().Post(srv.URL, "application/json", nil)
+		assert.ErrorContains(t, err, "is not public")
+	})
+
+	t.Run("redirects are not followed", func(t *testing.T) {
+		client := notifications.NewWebhookClient()
+		client.Transport = srv.Client().Transport
+
+		resp, err := client.Post(srv.URL, "application/json", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+	})
+}
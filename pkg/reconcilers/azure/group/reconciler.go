@@ -2,6 +2,7 @@ package azure_group_reconciler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	helpers "github.com/nais/console/pkg/console"
@@ -35,6 +36,19 @@ const (
 	OpDeleteMember = "azure:group:delete-member"
 )
 
+// GroupDetails Audit log details for actions on an Azure AD group
+type GroupDetails struct {
+	GroupID   string `json:"groupId"`
+	GroupName string `json:"groupName"`
+}
+
+// MemberDetails Audit log details for changes to the members of an Azure AD group
+type MemberDetails struct {
+	GroupID     string `json:"groupId"`
+	GroupName   string `json:"groupName"`
+	MemberEmail string `json:"memberEmail"`
+}
+
 func New(db *gorm.DB, system dbmodels.System, auditLogger auditlogger.AuditLogger, oauth clientcredentials.Config, client azureclient.Client, domain string) *azureGroupReconciler {
 	return &azureGroupReconciler{
 		db:          db,
@@ -79,7 +93,8 @@ func (r *azureGroupReconciler) Reconcile(ctx context.Context, input reconcilers.
 	}
 
 	if created {
-		r.auditLogger.Logf(OpCreate, input.Corr, r.system, nil, &input.Team, nil, "created Azure AD group: %s", grp)
+		details := GroupDetails{GroupID: grp.ID, GroupName: grp.MailNickname}
+		r.auditLogger.LogWithDetails(OpCreate, input.Corr, r.system, nil, &input.Team, nil, details, "created Azure AD group: %s", grp)
 
 		id, _ := uuid.Parse(grp.ID)
 		err = dbmodels.SetSystemState(r.db, *r.system.ID, *input.Team.ID, reconcilers.AzureState{GroupID: &id})
@@ -100,6 +115,84 @@ func (r *azureGroupReconciler) System() dbmodels.System {
 	return r.system
 }
 
+// CheckCredentials Fetch an access token for the Microsoft Graph API
+func (r *azureGroupReconciler) CheckCredentials(ctx context.Context) error {
+	_, err := r.oauth.Token(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch Azure AD access token: %w", err)
+	}
+
+	return nil
+}
+
+// DetectDrift Compare the Azure AD group of a Console team with the members of the Console team, without making any
+// changes
+func (r *azureGroupReconciler) DetectDrift(ctx context.Context, team dbmodels.Team) ([]reconcilers.Drift, error) {
+	state := &reconcilers.AzureState{}
+	err := dbmodels.LoadSystemState(r.db, *r.system.ID, *team.ID, state)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load system state for team '%s' in system '%s': %w", team.Slug, r.system.Name, err)
+	}
+
+	prefixedName := teamNameWithPrefix(team.Slug)
+	missingGroup := []reconcilers.Drift{{
+		Kind:        dbmodels.DriftKindMissingResource,
+		Resource:    prefixedName,
+		Message:     fmt.Sprintf("Azure AD group for team '%s' does not exist", team.Slug),
+		Correctable: true,
+	}}
+	if state.GroupID == nil {
+		return missingGroup, nil
+	}
+
+	grp, err := r.client.GetGroupById(ctx, *state.GroupID)
+	if errors.Is(err, azureclient.ErrGroupNotFound) {
+		return missingGroup, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	drift := make([]reconcilers.Drift, 0)
+	if grp.MailNickname != prefixedName {
+		drift = append(drift, reconcilers.Drift{
+			Kind:     dbmodels.DriftKindRenamed,
+			Resource: prefixedName,
+			Subject:  grp.MailNickname,
+			Message:  fmt.Sprintf("Azure AD group '%s' has mail nickname '%s', expected '%s'", grp.ID, grp.MailNickname, prefixedName),
+		})
+	}
+
+	members, err := r.client.ListGroupMembers(ctx, grp)
+	if err != nil {
+		return nil, fmt.Errorf("list existing members in Azure group '%s': %s", grp.MailNickname, err)
+	}
+
+	localMembers := helpers.DomainUsers(team.Users, r.domain)
+	for _, member := range remoteOnlyMembers(members, localMembers) {
+		email := strings.ToLower(member.Mail)
+		drift = append(drift, reconcilers.Drift{
+			Kind:        dbmodels.DriftKindExtraMember,
+			Resource:    prefixedName,
+			Subject:     email,
+			Message:     fmt.Sprintf("'%s' is a member of Azure group '%s', but not of the team", email, prefixedName),
+			Correctable: true,
+		})
+	}
+
+	for _, user := range localOnlyMembers(members, localMembers) {
+		drift = append(drift, reconcilers.Drift{
+			Kind:        dbmodels.DriftKindMissingMember,
+			Resource:    prefixedName,
+			Subject:     user.Email,
+			Message:     fmt.Sprintf("'%s' is a member of the team, but not of Azure group '%s'", user.Email, prefixedName),
+			Correctable: true,
+		})
+	}
+
+	return drift, nil
+}
+
 func (r *azureGroupReconciler) connectUsers(ctx context.Context, grp *azureclient.Group, corr dbmodels.Correlation, team dbmodels.Team) error {
 	members, err := r.client.ListGroupMembers(ctx, grp)
 	if err != nil {
@@ -122,7 +215,8 @@ func (r *azureGroupReconciler) connectUsers(ctx context.Context, grp *azureclien
 			consoleUserMap[remoteEmail] = dbmodels.GetUserByEmail(r.db, remoteEmail)
 		}
 
-		r.auditLogger.Logf(OpDeleteMember, corr, r.system, nil, &team, consoleUserMap[remoteEmail], "removed member '%s' from Azure group '%s'", remoteEmail, grp.MailNickname)
+		details := MemberDetails{GroupID: grp.ID, GroupName: grp.MailNickname, MemberEmail: remoteEmail}
+		r.auditLogger.LogWithDetails(OpDeleteMember, corr, r.system, nil, &team, consoleUserMap[remoteEmail], details, "removed member '%s' from Azure group '%s'", remoteEmail, grp.MailNickname)
 	}
 
 	membersToAdd := localOnlyMembers(members, localMembers)
@@ -138,7 +232,8 @@ func (r *azureGroupReconciler) connectUsers(ctx context.Context, grp *azureclien
 			continue
 		}
 
-		r.auditLogger.Logf(OpAddMember, corr, r.system, nil, &team, consoleUser, "added member '%s' to Azure group '%s'", member.Mail, grp.MailNickname)
+		details := MemberDetails{GroupID: grp.ID, GroupName: grp.MailNickname, MemberEmail: member.Mail}
+		r.auditLogger.LogWithDetails(OpAddMember, corr, r.system, nil, &team, consoleUser, details, "added member '%s' to Azure group '%s'", member.Mail, grp.MailNickname)
 	}
 
 	return nil
@@ -183,7 +183,7 @@ func TestAzureReconciler_Reconcile(t *testing.T) {
 		})
 
 		assert.NoError(t, err)
-		mockAuditLogger.AssertNotCalled(t, "Logf")
+		mockAuditLogger.AssertNotCalled(t, "LogWithDetails")
 		mockClient.AssertExpectations(t)
 	})
 
@@ -252,7 +252,81 @@ func TestAzureReconciler_Reconcile(t *testing.T) {
 		})
 
 		assert.NoError(t, err)
-		mockAuditLogger.AssertNotCalled(t, "Logf")
+		mockAuditLogger.AssertNotCalled(t, "LogWithDetails")
+		mockClient.AssertExpectations(t)
+	})
+}
+
+func TestAzureReconciler_DetectDrift(t *testing.T) {
+	const domain = "example.com"
+
+	ctx := context.Background()
+	groupID := uuid.New()
+	group := &azureclient.Group{
+		ID:           groupID.String(),
+		MailNickname: "nais-team-renamed",
+	}
+	system := dbmodels.System{Model: modelWithId()}
+	team := dbmodels.Team{
+		Model: modelWithId(),
+		Slug:  "slug",
+		Name:  "My team",
+		Users: []*dbmodels.User{
+			{Email: "missing@example.com"},
+			{Email: "keeper@example.com"},
+		},
+	}
+
+	t.Run("group no longer exists", func(t *testing.T) {
+		db := test.GetTestDB()
+		db.AutoMigrate(&dbmodels.SystemState{})
+		dbmodels.SetSystemState(db, *system.ID, *team.ID, reconcilers.AzureState{GroupID: &groupID})
+
+		mockClient := &azureclient.MockClient{}
+		mockClient.
+			On("GetGroupById", mock.Anything, groupID).
+			Return(nil, fmt.Errorf("azure group with ID '%s' does not exist: %w", groupID, azureclient.ErrGroupNotFound)).
+			Once()
+
+		reconciler := azure_group.New(db, system, nil, clientcredentials.Config{}, mockClient, domain)
+		drift, err := reconciler.DetectDrift(ctx, team)
+		assert.NoError(t, err)
+		assert.Len(t, drift, 1)
+		assert.Equal(t, dbmodels.DriftKindMissingResource, drift[0].Kind)
+		assert.Equal(t, "nais-team-slug", drift[0].Resource)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("renamed group with extra and missing members", func(t *testing.T) {
+		db := test.GetTestDB()
+		db.AutoMigrate(&dbmodels.SystemState{})
+		dbmodels.SetSystemState(db, *system.ID, *team.ID, reconcilers.AzureState{GroupID: &groupID})
+
+		mockClient := &azureclient.MockClient{}
+		mockClient.
+			On("GetGroupById", mock.Anything, groupID).
+			Return(group, nil).
+			Once()
+		mockClient.
+			On("ListGroupMembers", mock.Anything, group).
+			Return([]*azureclient.Member{{Mail: "Keeper@example.com"}, {Mail: "extra@example.com"}}, nil).
+			Once()
+
+		// Only read operations are mocked, so any attempt to change the group fails the test
+		reconciler := azure_group.New(db, system, nil, clientcredentials.Config{}, mockClient, domain)
+		drift, err := reconciler.DetectDrift(ctx, team)
+		assert.NoError(t, err)
+		assert.Len(t, drift, 3)
+
+		subjects := make(map[dbmodels.DriftKind]string)
+		for _, d := range drift {
+			subjects[d.Kind] = d.Subject
+			// The reconciler does not rename groups, so a renamed group is not corrected by reconciling
+			assert.Equal(t, d.Kind != dbmodels.DriftKindRenamed, d.Correctable)
+		}
+		assert.Equal(t, "nais-team-renamed", subjects[dbmodels.DriftKindRenamed])
+		assert.Equal(t, "extra@example.com", subjects[dbmodels.DriftKindExtraMember])
+		assert.Equal(t, "missing@example.com", subjects[dbmodels.DriftKindMissingMember])
 		mockClient.AssertExpectations(t)
 	})
 }
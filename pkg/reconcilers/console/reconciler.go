@@ -15,11 +15,30 @@ type consoleReconciler struct {
 }
 
 const (
-	Name         = "console"
-	OpCreateTeam = "console:team:create"
-	OpSyncTeam   = "console:team:sync"
+	Name                = "console"
+	OpCreateTeam        = "console:team:create"
+	OpSyncTeam          = "console:team:sync"
+	OpSetTeamMetadata   = "console:team:set-metadata"
+	OpClearTeamMetadata = "console:team:clear-metadata"
+
+	OpSetTeamRepository    = "console:team:set-repository"
+	OpRemoveTeamRepository = "console:team:remove-repository"
+
+	OpResolveGitHubTeamConflict = "console:team:resolve-github-conflict"
 )
 
+// RepositoryDetails Audit log details for a repository set on or removed from a team
+type RepositoryDetails struct {
+	Repository string `json:"repository"`
+	Permission string `json:"permission,omitempty"`
+}
+
+// GitHubTeamConflictDetails Audit log details for a resolved GitHub team conflict
+type GitHubTeamConflictDetails struct {
+	Resolution string `json:"resolution"`
+	Name       string `json:"name"`
+}
+
 func New(system dbmodels.System) *consoleReconciler {
 	return &consoleReconciler{
 		system: system,
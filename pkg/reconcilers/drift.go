@@ -0,0 +1,25 @@
+package reconcilers
+
+import (
+	"context"
+
+	"github.com/nais/console/pkg/dbmodels"
+)
+
+// Drift A difference between an external system and the desired state of a team
+type Drift struct {
+	Kind     dbmodels.DriftKind
+	Resource string // The external resource, for instance the name of a group or the ID of a project
+	Subject  string // What differs, for instance the email address of a member. Empty when the resource itself differs.
+	Message  string // Human readable description
+
+	// Correctable Whether reconciling the team corrects the drift. Drift that the reconciler does not repair, such as a
+	// renamed resource, is only reported.
+	Correctable bool
+}
+
+// DriftDetector Implemented by reconcilers that can compare the external system with the desired state of a team,
+// without making any changes
+type DriftDetector interface {
+	DetectDrift(ctx context.Context, team dbmodels.Team) ([]Drift, error)
+}
@@ -0,0 +1,41 @@
+package github_team_reconciler
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	metricsNamespace = "console"
+	metricsSubsystem = "github"
+)
+
+var (
+	rateLimitRemaining = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "rate_limit_remaining",
+		Help:      "Number of requests remaining in the current GitHub API rate limit window.",
+	})
+
+	rateLimitLimit = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "rate_limit_limit",
+		Help:      "Number of requests allowed in each GitHub API rate limit window.",
+	})
+
+	rateLimitedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "rate_limited_total",
+		Help:      "Number of GitHub API requests rejected because of a rate limit, by kind of limit.",
+	}, []string{"kind"})
+
+	rateLimitWaitSeconds = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "rate_limit_wait_seconds_total",
+		Help:      "Time spent waiting before GitHub API requests because of rate limits.",
+	})
+)
@@ -46,6 +46,29 @@ func (_m *MockTeamsService) AddTeamMembershipBySlug(ctx context.Context, org str
 	return r0, r1, r2
 }
 
+// AddTeamRepoBySlug provides a mock function with given fields: ctx, org, slug, owner, repo, opts
+func (_m *MockTeamsService) AddTeamRepoBySlug(ctx context.Context, org string, slug string, owner string, repo string, opts *github.TeamAddTeamRepoOptions) (*github.Response, error) {
+	ret := _m.Called(ctx, org, slug, owner, repo, opts)
+
+	var r0 *github.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, *github.TeamAddTeamRepoOptions) *github.Response); ok {
+		r0 = rf(ctx, org, slug, owner, repo, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*github.Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, *github.TeamAddTeamRepoOptions) error); ok {
+		r1 = rf(ctx, org, slug, owner, repo, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateTeam provides a mock function with given fields: ctx, org, team
 func (_m *MockTeamsService) CreateTeam(ctx context.Context, org string, team github.NewTeam) (*github.Team, *github.Response, error) {
 	ret := _m.Called(ctx, org, team)
@@ -78,6 +101,70 @@ func (_m *MockTeamsService) CreateTeam(ctx context.Context, org string, team git
 	return r0, r1, r2
 }
 
+// EditTeamBySlug provides a mock function with given fields: ctx, org, slug, team, removeParent
+func (_m *MockTeamsService) EditTeamBySlug(ctx context.Context, org string, slug string, team github.NewTeam, removeParent bool) (*github.Team, *github.Response, error) {
+	ret := _m.Called(ctx, org, slug, team, removeParent)
+
+	var r0 *github.Team
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, github.NewTeam, bool) *github.Team); ok {
+		r0 = rf(ctx, org, slug, team, removeParent)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*github.Team)
+		}
+	}
+
+	var r1 *github.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, github.NewTeam, bool) *github.Response); ok {
+		r1 = rf(ctx, org, slug, team, removeParent)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*github.Response)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, github.NewTeam, bool) error); ok {
+		r2 = rf(ctx, org, slug, team, removeParent)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetTeamByID provides a mock function with given fields: ctx, orgID, teamID
+func (_m *MockTeamsService) GetTeamByID(ctx context.Context, orgID int64, teamID int64) (*github.Team, *github.Response, error) {
+	ret := _m.Called(ctx, orgID, teamID)
+
+	var r0 *github.Team
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) *github.Team); ok {
+		r0 = rf(ctx, orgID, teamID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*github.Team)
+		}
+	}
+
+	var r1 *github.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) *github.Response); ok {
+		r1 = rf(ctx, orgID, teamID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*github.Response)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64) error); ok {
+		r2 = rf(ctx, orgID, teamID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetTeamBySlug provides a mock function with given fields: ctx, org, slug
 func (_m *MockTeamsService) GetTeamBySlug(ctx context.Context, org string, slug string) (*github.Team, *github.Response, error) {
 	ret := _m.Called(ctx, org, slug)
@@ -110,6 +197,38 @@ func (_m *MockTeamsService) GetTeamBySlug(ctx context.Context, org string, slug
 	return r0, r1, r2
 }
 
+// IsTeamRepoBySlug provides a mock function with given fields: ctx, org, slug, owner, repo
+func (_m *MockTeamsService) IsTeamRepoBySlug(ctx context.Context, org string, slug string, owner string, repo string) (*github.Repository, *github.Response, error) {
+	ret := _m.Called(ctx, org, slug, owner, repo)
+
+	var r0 *github.Repository
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *github.Repository); ok {
+		r0 = rf(ctx, org, slug, owner, repo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*github.Repository)
+		}
+	}
+
+	var r1 *github.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) *github.Response); ok {
+		r1 = rf(ctx, org, slug, owner, repo)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*github.Response)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string, string) error); ok {
+		r2 = rf(ctx, org, slug, owner, repo)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // ListTeamMembersBySlug provides a mock function with given fields: ctx, org, slug, opts
 func (_m *MockTeamsService) ListTeamMembersBySlug(ctx context.Context, org string, slug string, opts *github.TeamListTeamMembersOptions) ([]*github.User, *github.Response, error) {
 	ret := _m.Called(ctx, org, slug, opts)
@@ -165,6 +284,29 @@ func (_m *MockTeamsService) RemoveTeamMembershipBySlug(ctx context.Context, org
 	return r0, r1
 }
 
+// RemoveTeamRepoBySlug provides a mock function with given fields: ctx, org, slug, owner, repo
+func (_m *MockTeamsService) RemoveTeamRepoBySlug(ctx context.Context, org string, slug string, owner string, repo string) (*github.Response, error) {
+	ret := _m.Called(ctx, org, slug, owner, repo)
+
+	var r0 *github.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *github.Response); ok {
+		r0 = rf(ctx, org, slug, owner, repo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*github.Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, org, slug, owner, repo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type NewMockTeamsServiceT interface {
 	mock.TestingT
 	Cleanup(func())
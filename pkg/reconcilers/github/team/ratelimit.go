@@ -0,0 +1,277 @@
+package github_team_reconciler
+
+import (
+	"context"
+	"errors"
+	"github.com/google/go-github/v43/github"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// rateLimitReserve When fewer requests than this remain in the rate limit window, the remaining requests are spread
+	// out until the window resets
+	rateLimitReserve = 100
+
+	// rateLimitMaxRetries Number of times a request rejected because of a rate limit is retried
+	rateLimitMaxRetries = 3
+
+	// secondaryRateLimitBackoff How long to wait after a secondary rate limit when GitHub does not say how long to wait
+	secondaryRateLimitBackoff = 1 * time.Minute
+)
+
+// rateLimiter Keeps track of the GitHub API rate limit, shared by all requests made by the reconciler. Requests are
+// slowed down when the remaining quota is low, and paused after GitHub has rejected a request because of a rate limit.
+type rateLimiter struct {
+	lock sync.Mutex
+	next time.Time // No requests are made before this time
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		now:   time.Now,
+		sleep: sleepContext,
+	}
+}
+
+// wait Block until a request can be made, or until the context is done
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.lock.Lock()
+	delay := l.next.Sub(l.now())
+	l.lock.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	rateLimitWaitSeconds.Add(delay.Seconds())
+	return l.sleep(ctx, delay)
+}
+
+// observe Update the rate limit from the headers of a response. Returns true if the request was rejected because of a
+// rate limit, in which case it can be retried.
+func (l *rateLimiter) observe(resp *http.Response) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	remaining, hasRemaining := headerInt(resp.Header, "X-RateLimit-Remaining")
+	reset := now
+	if seconds, ok := headerInt(resp.Header, "X-RateLimit-Reset"); ok {
+		reset = time.Unix(int64(seconds), 0)
+	}
+
+	if limit, ok := headerInt(resp.Header, "X-RateLimit-Limit"); ok {
+		rateLimitLimit.Set(float64(limit))
+	}
+
+	if hasRemaining {
+		rateLimitRemaining.Set(float64(remaining))
+		if remaining > 0 && remaining < rateLimitReserve && reset.After(now) {
+			l.pause(now.Add(reset.Sub(now) / time.Duration(remaining+1)))
+		}
+	}
+
+	retryAfter, hasRetryAfter := headerInt(resp.Header, "Retry-After")
+
+	switch {
+	case hasRemaining && remaining == 0 && resp.StatusCode >= http.StatusBadRequest:
+		l.exhausted(reset)
+		return true
+	case resp.StatusCode == http.StatusTooManyRequests || (hasRetryAfter && resp.StatusCode == http.StatusForbidden):
+		backoff := secondaryRateLimitBackoff
+		if hasRetryAfter {
+			backoff = time.Duration(retryAfter) * time.Second
+		}
+		l.pause(now.Add(backoff))
+		rateLimitedTotal.WithLabelValues("secondary").Inc()
+		log.Warnf("GitHub API secondary rate limit exceeded, pausing requests for %s", backoff)
+		return true
+	}
+
+	return false
+}
+
+// exhausted Pause requests until the primary rate limit resets. Must be called with the lock held.
+func (l *rateLimiter) exhausted(reset time.Time) {
+	if !reset.After(l.now()) {
+		reset = l.now().Add(secondaryRateLimitBackoff)
+	}
+	l.pause(reset)
+	rateLimitedTotal.WithLabelValues("primary").Inc()
+	log.Warnf("GitHub API rate limit exceeded, pausing requests until %s", reset.Format(time.RFC3339))
+}
+
+// pause Make no requests before until. Must be called with the lock held.
+func (l *rateLimiter) pause(until time.Time) {
+	if until.After(l.next) {
+		l.next = until
+	}
+}
+
+// rateLimitTransport An http.RoundTripper that makes requests once the rate limit allows it, and keeps track of the rate
+// limit from the responses. Requests rejected because of a rate limit are retried when the limit allows it, up to
+// rateLimitMaxRetries times. Used by the HTTP client shared by the REST and GraphQL clients.
+type rateLimitTransport struct {
+	transport http.RoundTripper
+	limiter   *rateLimiter
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		err := t.limiter.wait(req.Context())
+		if err != nil {
+			return nil, err
+		}
+
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := t.transport.RoundTrip(attemptReq)
+		if err != nil || !t.limiter.observe(resp) || attempt == rateLimitMaxRetries {
+			return resp, err
+		}
+
+		// A request with a body that can not be sent again is not retried
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return resp, nil
+		}
+
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+}
+
+// withRateLimit Make a request once the rate limit allows it. The rate limit is kept track of by rateLimitTransport, but
+// go-github also rejects requests by itself, without making them, while it knows the rate limit to be exhausted. Such
+// requests are retried once the rate limit resets, up to rateLimitMaxRetries times.
+func withRateLimit[T any](ctx context.Context, l *rateLimiter, request func() (T, *github.Response, error)) (T, *github.Response, error) {
+	for attempt := 0; ; attempt++ {
+		err := l.wait(ctx)
+		if err != nil {
+			var empty T
+			return empty, nil, err
+		}
+
+		result, resp, err := request()
+		rateErr := &github.RateLimitError{}
+		if !errors.As(err, &rateErr) || attempt == rateLimitMaxRetries {
+			return result, resp, err
+		}
+
+		l.lock.Lock()
+		l.exhausted(rateErr.Rate.Reset.Time)
+		l.lock.Unlock()
+	}
+}
+
+func headerInt(header http.Header, key string) (int, bool) {
+	value := header.Get(key)
+	if value == "" {
+		return 0, false
+	}
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return i, true
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitedTeamsService A TeamsService that respects the GitHub API rate limit
+type rateLimitedTeamsService struct {
+	teamsService TeamsService
+	limiter      *rateLimiter
+}
+
+func (s *rateLimitedTeamsService) AddTeamMembershipBySlug(ctx context.Context, org, slug, user string, opts *github.TeamAddTeamMembershipOptions) (*github.Membership, *github.Response, error) {
+	return withRateLimit(ctx, s.limiter, func() (*github.Membership, *github.Response, error) {
+		return s.teamsService.AddTeamMembershipBySlug(ctx, org, slug, user, opts)
+	})
+}
+
+func (s *rateLimitedTeamsService) AddTeamRepoBySlug(ctx context.Context, org, slug, owner, repo string, opts *github.TeamAddTeamRepoOptions) (*github.Response, error) {
+	_, resp, err := withRateLimit(ctx, s.limiter, func() (struct{}, *github.Response, error) {
+		resp, err := s.teamsService.AddTeamRepoBySlug(ctx, org, slug, owner, repo, opts)
+		return struct{}{}, resp, err
+	})
+	return resp, err
+}
+
+func (s *rateLimitedTeamsService) CreateTeam(ctx context.Context, org string, team github.NewTeam) (*github.Team, *github.Response, error) {
+	return withRateLimit(ctx, s.limiter, func() (*github.Team, *github.Response, error) {
+		return s.teamsService.CreateTeam(ctx, org, team)
+	})
+}
+
+func (s *rateLimitedTeamsService) EditTeamBySlug(ctx context.Context, org, slug string, team github.NewTeam, removeParent bool) (*github.Team, *github.Response, error) {
+	return withRateLimit(ctx, s.limiter, func() (*github.Team, *github.Response, error) {
+		return s.teamsService.EditTeamBySlug(ctx, org, slug, team, removeParent)
+	})
+}
+
+func (s *rateLimitedTeamsService) GetTeamByID(ctx context.Context, orgID, teamID int64) (*github.Team, *github.Response, error) {
+	return withRateLimit(ctx, s.limiter, func() (*github.Team, *github.Response, error) {
+		return s.teamsService.GetTeamByID(ctx, orgID, teamID)
+	})
+}
+
+func (s *rateLimitedTeamsService) GetTeamBySlug(ctx context.Context, org, slug string) (*github.Team, *github.Response, error) {
+	return withRateLimit(ctx, s.limiter, func() (*github.Team, *github.Response, error) {
+		return s.teamsService.GetTeamBySlug(ctx, org, slug)
+	})
+}
+
+func (s *rateLimitedTeamsService) IsTeamRepoBySlug(ctx context.Context, org, slug, owner, repo string) (*github.Repository, *github.Response, error) {
+	return withRateLimit(ctx, s.limiter, func() (*github.Repository, *github.Response, error) {
+		return s.teamsService.IsTeamRepoBySlug(ctx, org, slug, owner, repo)
+	})
+}
+
+func (s *rateLimitedTeamsService) ListTeamMembersBySlug(ctx context.Context, org, slug string, opts *github.TeamListTeamMembersOptions) ([]*github.User, *github.Response, error) {
+	return withRateLimit(ctx, s.limiter, func() ([]*github.User, *github.Response, error) {
+		return s.teamsService.ListTeamMembersBySlug(ctx, org, slug, opts)
+	})
+}
+
+func (s *rateLimitedTeamsService) RemoveTeamMembershipBySlug(ctx context.Context, org, slug, user string) (*github.Response, error) {
+	_, resp, err := withRateLimit(ctx, s.limiter, func() (struct{}, *github.Response, error) {
+		resp, err := s.teamsService.RemoveTeamMembershipBySlug(ctx, org, slug, user)
+		return struct{}{}, resp, err
+	})
+	return resp, err
+}
+
+func (s *rateLimitedTeamsService) RemoveTeamRepoBySlug(ctx context.Context, org, slug, owner, repo string) (*github.Response, error) {
+	_, resp, err := withRateLimit(ctx, s.limiter, func() (struct{}, *github.Response, error) {
+		resp, err := s.teamsService.RemoveTeamRepoBySlug(ctx, org, slug, owner, repo)
+		return struct{}{}, resp, err
+	})
+	return resp, err
+}
@@ -0,0 +1,269 @@
+package github_team_reconciler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v43/github"
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/reconcilers"
+	"github.com/nais/console/pkg/test"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// fakeClock A clock that only advances when sleeping
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(_ context.Context, d time.Duration) error {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	return nil
+}
+
+func newTestLimiter() (*rateLimiter, *fakeClock) {
+	clock := &fakeClock{now: time.Unix(1_600_000_000, 0)}
+	limiter := newRateLimiter()
+	limiter.now = clock.Now
+	limiter.sleep = clock.Sleep
+	return limiter, clock
+}
+
+// fakeTransport Returns the responses in order, and records the bodies of the requests
+type fakeTransport struct {
+	responses []*http.Response
+	bodies    []string
+}
+
+func (f *fakeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		b, _ := io.ReadAll(req.Body)
+		body = string(b)
+	}
+	f.bodies = append(f.bodies, body)
+
+	resp := f.responses[0]
+	f.responses = f.responses[1:]
+	return resp, nil
+}
+
+func newTestClient(responses ...*http.Response) (*http.Client, *fakeTransport, *fakeClock) {
+	limiter, clock := newTestLimiter()
+	transport := &fakeTransport{responses: responses}
+	return &http.Client{Transport: &rateLimitTransport{transport: transport, limiter: limiter}}, transport, clock
+}
+
+func response(statusCode int, headers map[string]string) *http.Response {
+	header := http.Header{}
+	for key, value := range headers {
+		header.Set(key, value)
+	}
+	return &http.Response{StatusCode: statusCode, Header: header, Body: io.NopCloser(strings.NewReader(""))}
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	ctx := context.Background()
+	get := func(client *http.Client) *http.Response {
+		resp, err := client.Get("https://api.github.com/orgs/org/teams/slug")
+		assert.NoError(t, err)
+		return resp
+	}
+
+	t.Run("secondary rate limit with retry-after", func(t *testing.T) {
+		client, _, clock := newTestClient(
+			response(http.StatusForbidden, map[string]string{"Retry-After": "30"}),
+			response(http.StatusOK, nil),
+		)
+
+		assert.Equal(t, http.StatusOK, get(client).StatusCode)
+		assert.Equal(t, []time.Duration{30 * time.Second}, clock.sleeps)
+	})
+
+	t.Run("too many requests without retry-after", func(t *testing.T) {
+		client, _, clock := newTestClient(
+			response(http.StatusTooManyRequests, nil),
+			response(http.StatusNoContent, nil),
+		)
+
+		assert.Equal(t, http.StatusNoContent, get(client).StatusCode)
+		assert.Equal(t, []time.Duration{secondaryRateLimitBackoff}, clock.sleeps)
+	})
+
+	t.Run("primary rate limit exhausted", func(t *testing.T) {
+		limiter, clock := newTestLimiter()
+		reset := clock.now.Add(10 * time.Minute)
+		transport := &fakeTransport{responses: []*http.Response{
+			response(http.StatusForbidden, map[string]string{
+				"X-RateLimit-Limit":     "5000",
+				"X-RateLimit-Remaining": "0",
+				"X-RateLimit-Reset":     strconv.FormatInt(reset.Unix(), 10),
+			}),
+			response(http.StatusOK, nil),
+		}}
+		client := &http.Client{Transport: &rateLimitTransport{transport: transport, limiter: limiter}}
+
+		assert.Equal(t, http.StatusOK, get(client).StatusCode)
+		assert.Equal(t, []time.Duration{10 * time.Minute}, clock.sleeps)
+	})
+
+	t.Run("requests are spread out when the quota is low", func(t *testing.T) {
+		limiter, clock := newTestLimiter()
+		transport := &fakeTransport{responses: []*http.Response{
+			response(http.StatusOK, map[string]string{
+				"X-RateLimit-Remaining": "9",
+				"X-RateLimit-Reset":     strconv.FormatInt(clock.now.Add(100*time.Second).Unix(), 10),
+			}),
+			response(http.StatusOK, map[string]string{"X-RateLimit-Remaining": "4000"}),
+			response(http.StatusOK, map[string]string{"X-RateLimit-Remaining": "4000"}),
+		}}
+		client := &http.Client{Transport: &rateLimitTransport{transport: transport, limiter: limiter}}
+
+		for i := 0; i < 3; i++ {
+			get(client)
+		}
+		assert.Equal(t, []time.Duration{10 * time.Second}, clock.sleeps)
+	})
+
+	t.Run("gives up after retrying", func(t *testing.T) {
+		responses := make([]*http.Response, 0)
+		for i := 0; i <= rateLimitMaxRetries; i++ {
+			responses = append(responses, response(http.StatusTooManyRequests, map[string]string{"Retry-After": "1"}))
+		}
+		client, transport, clock := newTestClient(responses...)
+
+		assert.Equal(t, http.StatusTooManyRequests, get(client).StatusCode)
+		assert.Len(t, clock.sleeps, rateLimitMaxRetries)
+		assert.Empty(t, transport.responses)
+	})
+
+	t.Run("other errors are not retried", func(t *testing.T) {
+		client, _, clock := newTestClient(response(http.StatusForbidden, map[string]string{"X-RateLimit-Remaining": "4000"}))
+
+		assert.Equal(t, http.StatusForbidden, get(client).StatusCode)
+		assert.Empty(t, clock.sleeps)
+	})
+
+	t.Run("request body is sent again when retrying", func(t *testing.T) {
+		client, transport, _ := newTestClient(
+			response(http.StatusTooManyRequests, map[string]string{"Retry-After": "1"}),
+			response(http.StatusOK, nil),
+		)
+
+		resp, err := client.Post("https://api.github.com/graphql", "application/json", strings.NewReader(`{"query":"{}"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []string{`{"query":"{}"}`, `{"query":"{}"}`}, transport.bodies)
+	})
+
+	t.Run("GraphQL requests are rate limited", func(t *testing.T) {
+		ok := response(http.StatusOK, nil)
+		ok.Body = io.NopCloser(strings.NewReader(`{"data":{"viewer":{"login":"console"}}}`))
+		client, _, clock := newTestClient(
+			response(http.StatusForbidden, map[string]string{"Retry-After": "30"}),
+			ok,
+		)
+
+		var query struct {
+			Viewer struct {
+				Login githubv4.String
+			}
+		}
+		err := githubv4.NewClient(client).Query(ctx, &query, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, githubv4.String("console"), query.Viewer.Login)
+		assert.Equal(t, []time.Duration{30 * time.Second}, clock.sleeps)
+	})
+
+	t.Run("canceled context while paused", func(t *testing.T) {
+		limiter := newRateLimiter()
+		limiter.pause(time.Now().Add(time.Hour))
+		client := &http.Client{Transport: &rateLimitTransport{transport: &fakeTransport{}, limiter: limiter}}
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.github.com/orgs/org/teams/slug", nil)
+		_, err := client.Do(req)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestRateLimitedTeamsService(t *testing.T) {
+	ctx := context.Background()
+	team := &github.Team{Slug: github.String("slug")}
+
+	t.Run("requests rejected by go-github wait for the rate limit to reset", func(t *testing.T) {
+		limiter, clock := newTestLimiter()
+		teamsService := NewMockTeamsService(t)
+		service := &rateLimitedTeamsService{teamsService: teamsService, limiter: limiter}
+
+		reset := clock.now.Add(10 * time.Minute)
+		rateErr := &github.RateLimitError{Rate: github.Rate{Reset: github.Timestamp{Time: reset}}}
+		teamsService.On("GetTeamBySlug", ctx, "org", "slug").Return(nil, &github.Response{Response: response(http.StatusForbidden, nil)}, rateErr).Once()
+		teamsService.On("GetTeamBySlug", ctx, "org", "slug").Return(team, &github.Response{Response: response(http.StatusOK, nil)}, nil).Once()
+
+		result, _, err := service.GetTeamBySlug(ctx, "org", "slug")
+		assert.NoError(t, err)
+		assert.Equal(t, team, result)
+		assert.Equal(t, []time.Duration{10 * time.Minute}, clock.sleeps)
+	})
+
+	t.Run("canceled context while paused", func(t *testing.T) {
+		teamsService := NewMockTeamsService(t)
+		limiter := newRateLimiter()
+		limiter.pause(time.Now().Add(time.Hour))
+		service := &rateLimitedTeamsService{teamsService: teamsService, limiter: limiter}
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, resp, err := service.GetTeamBySlug(ctx, "org", "slug")
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, resp)
+	})
+}
+
+func TestReconcile_CanceledWhileRateLimited(t *testing.T) {
+	systemID, teamID := uuid.New(), uuid.New()
+	system := dbmodels.System{Model: dbmodels.Model{ID: &systemID}, Name: Name}
+	team := dbmodels.Team{Model: dbmodels.Model{ID: &teamID}, Slug: "myteam"}
+	githubTeam := &github.Team{Slug: github.String("myteam")}
+
+	db := test.GetTestDB()
+	db.AutoMigrate(&dbmodels.SystemState{}, &dbmodels.TeamRepository{})
+	dbmodels.SetSystemState(db, systemID, teamID, reconcilers.GitHubState{Slug: github.String("myteam")})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	teamsService := NewMockTeamsService(t)
+	reconciler := New(db, system, auditlogger.NewMockAuditLogger(t), "org", "example.com", teamsService, NewMockGraphClient(t))
+	limiter := reconciler.teamsService.(*rateLimitedTeamsService).limiter
+
+	// The rate limit is exhausted after the team has been fetched, and the reconcile times out while waiting
+	teamsService.On("GetTeamBySlug", mock.Anything, "org", "myteam").
+		Run(func(mock.Arguments) {
+			limiter.pause(time.Now().Add(time.Hour))
+			cancel()
+		}).
+		Return(githubTeam, &github.Response{Response: response(http.StatusOK, nil)}, nil).Once()
+
+	err := reconciler.Reconcile(ctx, reconcilers.Input{Team: team})
+	assert.ErrorIs(t, err, context.Canceled)
+}
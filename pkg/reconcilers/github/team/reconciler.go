@@ -17,6 +17,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 )
 
 const (
@@ -26,22 +27,67 @@ const (
 	OpAddMember    = "github:team:add-member"
 	OpDeleteMember = "github:team:delete-member"
 	OpMapSSOUser   = "github:team:map-sso-user"
+
+	OpSetRepositoryPermission = "github:team:set-repository-permission"
+	OpRemoveRepository        = "github:team:remove-repository"
+	OpSetParentTeam           = "github:team:set-parent"
+	OpRemoveParentTeam        = "github:team:remove-parent"
+
+	// MetadataParentTeam Team metadata key for the slug of the parent GitHub team, overriding the configured parent team.
+	// Only the configured parent team and teams nested below it can be used.
+	MetadataParentTeam = "github-parent-team"
+
+	// maxParentTeamDepth How far up the hierarchy of GitHub teams to look for the configured parent team
+	maxParentTeamDepth = 10
 )
 
+// DefaultIdentityCacheTTL How long SAML identities are cached by default
+const DefaultIdentityCacheTTL = 1 * time.Hour
+
+// identityMissRefreshInterval How long to wait between refreshing the cached SAML identities because a team member
+// has no cached identity. Users who have just linked their GitHub account are picked up without waiting for the cache
+// to expire, while users who never link their account don't cause a refresh on every reconcile.
+const identityMissRefreshInterval = 5 * time.Minute
+
 var errGitHubUserNotFound = errors.New("GitHub user does not exist")
 
+// repositoryPermissions Maps repository permissions in Console to the permission names used by the GitHub API
+var repositoryPermissions = map[dbmodels.RepositoryPermission]string{
+	dbmodels.RepositoryPermissionRead:     "pull",
+	dbmodels.RepositoryPermissionTriage:   "triage",
+	dbmodels.RepositoryPermissionWrite:    "push",
+	dbmodels.RepositoryPermissionMaintain: "maintain",
+	dbmodels.RepositoryPermissionAdmin:    "admin",
+}
+
+// New Create a GitHub team reconciler
 func New(db *gorm.DB, system dbmodels.System, auditLogger auditlogger.AuditLogger, org, domain string, teamsService TeamsService, graphClient GraphClient) *githubTeamReconciler {
+	return newWithRateLimiter(db, system, auditLogger, org, domain, teamsService, graphClient, newRateLimiter())
+}
+
+// newWithRateLimiter Create a GitHub team reconciler sharing the rate limiter with the HTTP client of teamsService and
+// graphClient
+func newWithRateLimiter(db *gorm.DB, system dbmodels.System, auditLogger auditlogger.AuditLogger, org, domain string, teamsService TeamsService, graphClient GraphClient, limiter *rateLimiter) *githubTeamReconciler {
 	return &githubTeamReconciler{
 		db:           db,
 		system:       system,
 		auditLogger:  auditLogger,
 		org:          org,
 		domain:       domain,
-		teamsService: teamsService,
+		teamsService: &rateLimitedTeamsService{teamsService: teamsService, limiter: limiter},
 		graphClient:  graphClient,
+
+		identityCacheTTL: DefaultIdentityCacheTTL,
 	}
 }
 
+// WithParentTeam Create and keep GitHub teams below the parent team with the given slug. Teams can choose another
+// parent team nested below it through their metadata.
+func (r *githubTeamReconciler) WithParentTeam(slug string) *githubTeamReconciler {
+	r.parentTeam = slug
+	return r
+}
+
 func NewFromConfig(db *gorm.DB, cfg *config.Config, system dbmodels.System, auditLogger auditlogger.AuditLogger) (reconcilers.Reconciler, error) {
 	if !cfg.GitHub.Enabled {
 		return nil, reconcilers.ErrReconcilerNotEnabled
@@ -58,14 +104,25 @@ func NewFromConfig(db *gorm.DB, cfg *config.Config, system dbmodels.System, audi
 	}
 
 	// Note that both HTTP clients and transports are safe for concurrent use according to the docs,
-	// so we can safely reuse them across objects and concurrent synchronizations.
+	// so we can safely reuse them across objects and concurrent synchronizations. All requests to the GitHub API share
+	// a rate limiter, which slows down requests when the rate limit is nearly exhausted, and retries requests rejected
+	// because of a rate limit.
+	limiter := newRateLimiter()
 	httpClient := &http.Client{
-		Transport: transport,
+		Transport: &rateLimitTransport{
+			transport: transport,
+			limiter:   limiter,
+		},
 	}
 	restClient := github.NewClient(httpClient)
 	graphClient := githubv4.NewClient(httpClient)
 
-	return New(db, system, auditLogger, cfg.GitHub.Organization, cfg.TenantDomain, restClient.Teams, graphClient), nil
+	reconciler := newWithRateLimiter(db, system, auditLogger, cfg.GitHub.Organization, cfg.TenantDomain, restClient.Teams, graphClient, limiter)
+	reconciler.installation = transport
+	reconciler.identityCacheTTL = cfg.GitHub.IdentityCacheTTL
+	reconciler.WithParentTeam(cfg.GitHub.ParentTeam)
+
+	return reconciler, nil
 }
 
 func (r *githubTeamReconciler) Reconcile(ctx context.Context, input reconcilers.Input) error {
@@ -75,34 +132,197 @@ func (r *githubTeamReconciler) Reconcile(ctx context.Context, input reconcilers.
 		return fmt.Errorf("unable to load system state for team '%s' in system '%s': %w", input.Team.Slug, r.system.Name, err)
 	}
 
-	githubTeam, err := r.getOrCreateTeam(ctx, *state, input.Corr, input.Team)
+	// A parent team that can not be used is reported once the members have been synchronized, and the GitHub team is
+	// left where it is until the parent team has been fixed
+	parentTeam, parentErr := r.getParentTeam(ctx, input.Team)
+
+	githubTeam, err := r.getOrCreateTeam(ctx, *state, input.Corr, input.Team, parentTeam)
 	if err != nil {
 		return fmt.Errorf("unable to get or create a GitHub team for team '%s' in system '%s': %w", input.Team.Slug, r.system.Name, err)
 	}
 
-	err = dbmodels.SetSystemState(r.db, *r.system.ID, *input.Team.ID, reconcilers.GitHubState{Slug: githubTeam.Slug})
+	state.Slug = githubTeam.Slug
+	state.ID = githubTeam.ID
+	if githubTeam.Organization != nil {
+		state.OrganizationID = githubTeam.Organization.ID
+	}
+	if parentErr == nil {
+		parentErr = r.syncParentTeam(ctx, githubTeam, parentTeam, state, input.Corr, input.Team)
+	}
+	repositoriesErr := r.syncRepositories(ctx, githubTeam, state, input.Corr, input.Team)
+
+	err = dbmodels.SetSystemState(r.db, *r.system.ID, *input.Team.ID, *state)
 	if err != nil {
 		log.Errorf("system state not persisted: %s", err)
 	}
 
-	return r.connectUsers(ctx, githubTeam, input.Corr, input.Team)
+	err = r.connectUsers(ctx, githubTeam, input.Corr, input.Team)
+	if err != nil {
+		return err
+	}
+
+	// Members are synchronized even if the parent team or some repositories could not be, as the team can not fix those
+	// on its own
+	switch {
+	case parentErr != nil && repositoriesErr != nil:
+		return fmt.Errorf("%s; %s", parentErr, repositoriesErr)
+	case parentErr != nil:
+		return parentErr
+	default:
+		return repositoriesErr
+	}
 }
 
 func (r *githubTeamReconciler) System() dbmodels.System {
 	return r.system
 }
 
-func (r *githubTeamReconciler) getOrCreateTeam(ctx context.Context, state reconcilers.GitHubState, corr dbmodels.Correlation, team dbmodels.Team) (*github.Team, error) {
-	if state.Slug != nil {
-		existingTeam, resp, err := r.teamsService.GetTeamBySlug(ctx, r.org, *state.Slug)
+// CheckCredentials Fetch an access token for the GitHub App installation
+func (r *githubTeamReconciler) CheckCredentials(ctx context.Context) error {
+	if r.installation == nil {
+		return nil
+	}
+
+	_, err := r.installation.Token(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch GitHub App installation token: %w", err)
+	}
+
+	return nil
+}
+
+// DetectDrift Compare the GitHub team of a Console team with the members of the Console team, without making any
+// changes. Members without a GitHub account connected through SSO are ignored, just as when reconciling.
+func (r *githubTeamReconciler) DetectDrift(ctx context.Context, team dbmodels.Team) ([]reconcilers.Drift, error) {
+	state := &reconcilers.GitHubState{}
+	err := dbmodels.LoadSystemState(r.db, *r.system.ID, *team.ID, state)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load system state for team '%s' in system '%s': %w", team.Slug, r.system.Name, err)
+	}
+
+	missingTeam := []reconcilers.Drift{{
+		Kind:        dbmodels.DriftKindMissingResource,
+		Resource:    string(team.Slug),
+		Message:     fmt.Sprintf("GitHub team for team '%s' does not exist", team.Slug),
+		Correctable: true,
+	}}
+	if state.Slug == nil && state.ID == nil {
+		return missingTeam, nil
+	}
+
+	githubTeam, err := r.getExistingTeam(ctx, *state)
+	if err != nil {
+		return nil, err
+	}
+	if githubTeam == nil {
+		return missingTeam, nil
+	}
+
+	slug := githubTeam.GetSlug()
+	expectedName := string(team.Slug)
+	if state.Name != nil {
+		expectedName = *state.Name
+	}
+
+	drift := make([]reconcilers.Drift, 0)
+	if githubTeam.GetName() != expectedName {
+		drift = append(drift, reconcilers.Drift{
+			Kind:     dbmodels.DriftKindRenamed,
+			Resource: slug,
+			Subject:  githubTeam.GetName(),
+			Message:  fmt.Sprintf("GitHub team '%s' is named '%s', expected '%s'", slug, githubTeam.GetName(), expectedName),
+		})
+	}
+
+	membersAccordingToGitHub, err := r.getTeamMembers(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("list existing members in GitHub team '%s': %w", slug, err)
+	}
+
+	consoleUserWithGitHubUser, err := r.mapSSOUsers(ctx, helpers.DomainUsers(team.Users, r.domain))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, gitHubUser := range remoteOnlyMembers(membersAccordingToGitHub, consoleUserWithGitHubUser) {
+		drift = append(drift, reconcilers.Drift{
+			Kind:        dbmodels.DriftKindExtraMember,
+			Resource:    slug,
+			Subject:     gitHubUser.GetLogin(),
+			Message:     fmt.Sprintf("'%s' is a member of GitHub team '%s', but not of the team", gitHubUser.GetLogin(), slug),
+			Correctable: true,
+		})
+	}
+
+	for username, consoleUser := range localOnlyMembers(consoleUserWithGitHubUser, membersAccordingToGitHub) {
+		drift = append(drift, reconcilers.Drift{
+			Kind:        dbmodels.DriftKindMissingMember,
+			Resource:    slug,
+			Subject:     username,
+			Message:     fmt.Sprintf("'%s' (%s) is a member of the team, but not of GitHub team '%s'", username, consoleUser.Email, slug),
+			Correctable: true,
+		})
+	}
+
+	return drift, nil
+}
+
+// getOrCreateTeam Get the GitHub team of the team, or create it under the parent team if it does not exist. Creating the
+// team fails with dbmodels.ErrReconcileConflict if the organization already has a GitHub team with the same name.
+func (r *githubTeamReconciler) getOrCreateTeam(ctx context.Context, state reconcilers.GitHubState, corr dbmodels.Correlation, team dbmodels.Team, parentTeam *github.Team) (*github.Team, error) {
+	existingTeam, err := r.getExistingTeam(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	if existingTeam != nil {
+		return existingTeam, nil
+	}
+
+	name := string(team.Slug)
+	if state.Name != nil {
+		name = *state.Name
+	}
+
+	description := helpers.TeamPurpose(team.Purpose)
+	newTeam := github.NewTeam{
+		Name:        name,
+		Description: &description,
+	}
+	if parentTeam != nil {
+		newTeam.ParentTeamID = parentTeam.ID
+	}
+
+	githubTeam, resp, err := r.teamsService.CreateTeam(ctx, r.org, newTeam)
+	if isNameConflict(resp, err) {
+		return nil, fmt.Errorf("%w: GitHub team '%s' already exists in organization '%s' and is not managed by Console; an administrator can adopt the existing team, or choose another name for the GitHub team", dbmodels.ErrReconcileConflict, name, r.org)
+	}
+	err = httpError(http.StatusCreated, resp, err)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create GitHub team: %w", err)
+	}
+
+	details := TeamDetails{TeamSlug: *githubTeam.Slug}
+	r.auditLogger.LogWithDetails(OpCreate, corr, r.system, nil, &team, nil, details, "created GitHub team '%s'", *githubTeam.Slug)
+
+	return githubTeam, nil
+}
+
+// getExistingTeam Get the GitHub team in the state, or nil if it no longer exists. The team is looked up by ID when
+// possible, so that it is found even if it has been renamed outside Console, and by slug otherwise.
+func (r *githubTeamReconciler) getExistingTeam(ctx context.Context, state reconcilers.GitHubState) (*github.Team, error) {
+	if state.ID != nil && state.OrganizationID != nil {
+		existingTeam, resp, err := r.teamsService.GetTeamByID(ctx, *state.OrganizationID, *state.ID)
 		if resp == nil && err != nil {
-			return nil, fmt.Errorf("unable to fetch GitHub team '%s': %w", *state.Slug, err)
+			return nil, fmt.Errorf("unable to fetch GitHub team with ID %d: %w", *state.ID, err)
 		}
 
 		switch resp.StatusCode {
 		case http.StatusNotFound:
 			break
 		case http.StatusOK:
+			if state.Slug != nil && existingTeam.GetSlug() != *state.Slug {
+				log.Infof("GitHub team '%s' has been renamed to '%s'", *state.Slug, existingTeam.GetSlug())
+			}
 			return existingTeam, nil
 		default:
 			body, _ := ioutil.ReadAll(resp.Body)
@@ -110,19 +330,24 @@ func (r *githubTeamReconciler) getOrCreateTeam(ctx context.Context, state reconc
 		}
 	}
 
-	description := helpers.TeamPurpose(team.Purpose)
-	githubTeam, resp, err := r.teamsService.CreateTeam(ctx, r.org, github.NewTeam{
-		Name:        string(team.Slug),
-		Description: &description,
-	})
-	err = httpError(http.StatusCreated, *resp, err)
-	if err != nil {
-		return nil, fmt.Errorf("unable to create GitHub team: %w", err)
+	if state.Slug == nil {
+		return nil, nil
 	}
 
-	r.auditLogger.Logf(OpCreate, corr, r.system, nil, &team, nil, "created GitHub team '%s'", *githubTeam.Slug)
+	existingTeam, resp, err := r.teamsService.GetTeamBySlug(ctx, r.org, *state.Slug)
+	if resp == nil && err != nil {
+		return nil, fmt.Errorf("unable to fetch GitHub team '%s': %w", *state.Slug, err)
+	}
 
-	return githubTeam, nil
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return nil, nil
+	case http.StatusOK:
+		return existingTeam, nil
+	default:
+		body, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("server error from GitHub: %s: %s", resp.Status, string(body))
+	}
 }
 
 func (r *githubTeamReconciler) connectUsers(ctx context.Context, githubTeam *github.Team, corr dbmodels.Correlation, team dbmodels.Team) error {
@@ -142,14 +367,14 @@ func (r *githubTeamReconciler) connectUsers(ctx context.Context, githubTeam *git
 	for _, gitHubUser := range membersToRemove {
 		username := gitHubUser.GetLogin()
 		resp, err := r.teamsService.RemoveTeamMembershipBySlug(ctx, r.org, *githubTeam.Slug, username)
-		err = httpError(http.StatusNoContent, *resp, err)
+		err = httpError(http.StatusNoContent, resp, err)
 		if err != nil {
 			log.Warnf("%s: unable to remove member '%s' from GitHub team '%s': %s", OpDeleteMember, username, *githubTeam.Slug, err)
 			continue
 		}
 
 		targetUser = nil
-		email, err := r.getEmailFromGitHubUsername(ctx, username)
+		email, err := r.getEmailFromGitHubUsername(username)
 		if err != nil {
 			log.Warnf("%s: unable to get email from GitHub username '%s' for audit log purposes: %s", OpDeleteMember, username, err)
 		}
@@ -158,24 +383,243 @@ func (r *githubTeamReconciler) connectUsers(ctx context.Context, githubTeam *git
 			targetUser = dbmodels.GetUserByEmail(r.db, *email)
 		}
 
-		r.auditLogger.Logf(OpDeleteMember, corr, r.system, nil, &team, targetUser, "deleted member '%s' from GitHub team '%s'", username, *githubTeam.Slug)
+		details := MemberDetails{TeamSlug: *githubTeam.Slug, Username: username}
+		r.auditLogger.LogWithDetails(OpDeleteMember, corr, r.system, nil, &team, targetUser, details, "deleted member '%s' from GitHub team '%s'", username, *githubTeam.Slug)
 	}
 
 	membersToAdd := localOnlyMembers(consoleUserWithGitHubUser, membersAccordingToGitHub)
 	for username, consoleUser := range membersToAdd {
 		_, resp, err := r.teamsService.AddTeamMembershipBySlug(ctx, r.org, *githubTeam.Slug, username, &github.TeamAddTeamMembershipOptions{})
-		err = httpError(http.StatusOK, *resp, err)
+		err = httpError(http.StatusOK, resp, err)
 		if err != nil {
 			log.Warnf("%s: unable to add member '%s' to GitHub team '%s': %s", OpAddMember, username, *githubTeam.Slug, err)
 			continue
 		}
 
-		r.auditLogger.Logf(OpAddMember, corr, r.system, nil, &team, consoleUser, "added member '%s' to GitHub team '%s'", username, *githubTeam.Slug)
+		details := MemberDetails{TeamSlug: *githubTeam.Slug, Username: username}
+		r.auditLogger.LogWithDetails(OpAddMember, corr, r.system, nil, &team, consoleUser, details, "added member '%s' to GitHub team '%s'", username, *githubTeam.Slug)
+	}
+
+	return nil
+}
+
+// getParentTeam Get the GitHub team that the GitHub team of the team should be a child of, or nil if it should not have
+// a parent. A parent team set in the metadata of the team takes precedence over the configured parent team, but must be
+// nested below the configured parent team, as child teams inherit the repository access of their parents.
+func (r *githubTeamReconciler) getParentTeam(ctx context.Context, team dbmodels.Team) (*github.Team, error) {
+	slug := r.parentTeam
+	for _, metadata := range team.Metadata {
+		if metadata.Key == MetadataParentTeam && metadata.Value != nil && *metadata.Value != "" {
+			slug = *metadata.Value
+		}
+	}
+
+	if slug == "" {
+		return nil, nil
+	}
+
+	parentTeam, err := r.getTeamBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", OpSetParentTeam, err)
+	}
+
+	if slug == r.parentTeam {
+		return parentTeam, nil
+	}
+
+	if r.parentTeam == "" {
+		return nil, fmt.Errorf("%s: parent GitHub team '%s' can not be used, as no parent team has been configured", OpSetParentTeam, slug)
+	}
+
+	ancestor := parentTeam
+	for depth := 0; depth < maxParentTeamDepth && ancestor.GetParent() != nil; depth++ {
+		if ancestor.GetParent().GetSlug() == r.parentTeam {
+			return parentTeam, nil
+		}
+
+		ancestor, err = r.getTeamBySlug(ctx, ancestor.GetParent().GetSlug())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", OpSetParentTeam, err)
+		}
+	}
+
+	return nil, fmt.Errorf("%s: parent GitHub team '%s' is not nested below the configured parent team '%s'", OpSetParentTeam, slug, r.parentTeam)
+}
+
+// getTeamBySlug Get an existing GitHub team in the organization
+func (r *githubTeamReconciler) getTeamBySlug(ctx context.Context, slug string) (*github.Team, error) {
+	githubTeam, resp, err := r.teamsService.GetTeamBySlug(ctx, r.org, slug)
+	if resp == nil && err != nil {
+		return nil, fmt.Errorf("unable to fetch GitHub team '%s': %w", slug, err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("parent GitHub team '%s' does not exist", slug)
+	}
+	err = httpError(http.StatusOK, resp, err)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch GitHub team '%s': %w", slug, err)
+	}
+
+	return githubTeam, nil
+}
+
+// syncParentTeam Make the GitHub team a child of the parent team. When the team should no longer have a parent, a parent
+// set by Console is removed, while a parent set outside Console is left untouched. The parent set by Console is stored
+// in the state.
+func (r *githubTeamReconciler) syncParentTeam(ctx context.Context, githubTeam *github.Team, parentTeam *github.Team, state *reconcilers.GitHubState, corr dbmodels.Correlation, team dbmodels.Team) error {
+	slug := githubTeam.GetSlug()
+	currentParentID := githubTeam.GetParent().GetID()
+
+	if parentTeam == nil {
+		if state.ParentID == nil {
+			return nil
+		}
+
+		if currentParentID == *state.ParentID {
+			_, resp, err := r.teamsService.EditTeamBySlug(ctx, r.org, slug, github.NewTeam{Name: githubTeam.GetName()}, true)
+			if err == nil {
+				err = httpError(http.StatusOK, resp, nil)
+			}
+			if err != nil {
+				return fmt.Errorf("%s: unable to remove parent team from GitHub team '%s': %w", OpRemoveParentTeam, slug, err)
+			}
+
+			parentSlug := ""
+			if state.ParentSlug != nil {
+				parentSlug = *state.ParentSlug
+			}
+			details := ParentTeamDetails{TeamSlug: slug, ParentTeamSlug: parentSlug}
+			r.auditLogger.LogWithDetails(OpRemoveParentTeam, corr, r.system, nil, &team, nil, details, "removed GitHub team '%s' from parent team '%s'", slug, parentSlug)
+		}
+
+		state.ParentID = nil
+		state.ParentSlug = nil
+		return nil
+	}
+
+	if currentParentID != parentTeam.GetID() {
+		// Secret teams can not be nested
+		_, resp, err := r.teamsService.EditTeamBySlug(ctx, r.org, slug, github.NewTeam{
+			Name:         githubTeam.GetName(),
+			ParentTeamID: parentTeam.ID,
+			Privacy:      github.String("closed"),
+		}, false)
+		if err == nil {
+			err = httpError(http.StatusOK, resp, nil)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: unable to move GitHub team '%s' under parent team '%s': %w", OpSetParentTeam, slug, parentTeam.GetSlug(), err)
+		}
+
+		details := ParentTeamDetails{TeamSlug: slug, ParentTeamSlug: parentTeam.GetSlug()}
+		r.auditLogger.LogWithDetails(OpSetParentTeam, corr, r.system, nil, &team, nil, details, "moved GitHub team '%s' under parent team '%s'", slug, parentTeam.GetSlug())
+	}
+
+	state.ParentID = parentTeam.ID
+	state.ParentSlug = parentTeam.Slug
+	return nil
+}
+
+// syncRepositories Grant the GitHub team the permissions declared for the repositories of the team, and revoke access to
+// repositories that were granted by Console but are no longer declared. Access to other repositories is never touched.
+// The repositories managed by Console are stored in the state, so that access can be revoked later on. Repositories that
+// could not be synchronized are skipped, and reported together in the returned error.
+func (r *githubTeamReconciler) syncRepositories(ctx context.Context, githubTeam *github.Team, state *reconcilers.GitHubState, corr dbmodels.Correlation, team dbmodels.Team) error {
+	repositories := make([]*dbmodels.TeamRepository, 0)
+	err := r.db.Where("team_id = ?", team.ID).Order("name ASC").Find(&repositories).Error
+	if err != nil {
+		return fmt.Errorf("%s: get repositories of team '%s': %w", OpSetRepositoryPermission, team.Slug, err)
+	}
+
+	slug := *githubTeam.Slug
+	declared := make(map[string]bool, len(repositories))
+	managed := make([]string, 0, len(repositories))
+	failures := make([]string, 0)
+
+	for _, repository := range repositories {
+		declared[repository.Name] = true
+		managed = append(managed, repository.Name)
+
+		permission := repositoryPermissions[repository.Permission]
+		current, err := r.getRepositoryPermission(ctx, slug, repository.Name)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("unable to get permission on repository '%s': %s", repository.Name, err))
+			continue
+		}
+
+		if current == permission {
+			continue
+		}
+
+		resp, err := r.teamsService.AddTeamRepoBySlug(ctx, r.org, slug, r.org, repository.Name, &github.TeamAddTeamRepoOptions{Permission: permission})
+		if err == nil {
+			err = httpError(http.StatusNoContent, resp, nil)
+		}
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("unable to set permission '%s' on repository '%s': %s", permission, repository.Name, err))
+			continue
+		}
+
+		details := RepositoryDetails{TeamSlug: slug, Repository: repository.Name, Permission: permission}
+		r.auditLogger.LogWithDetails(OpSetRepositoryPermission, corr, r.system, nil, &team, nil, details, "set permission '%s' for GitHub team '%s' on repository '%s'", permission, slug, repository.Name)
+	}
+
+	for _, name := range state.Repositories {
+		if declared[name] {
+			continue
+		}
+
+		resp, err := r.teamsService.RemoveTeamRepoBySlug(ctx, r.org, slug, r.org, name)
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			// The repository no longer exists
+			continue
+		}
+		if err == nil {
+			err = httpError(http.StatusNoContent, resp, nil)
+		}
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("unable to remove repository '%s': %s", name, err))
+			managed = append(managed, name)
+			continue
+		}
+
+		details := RepositoryDetails{TeamSlug: slug, Repository: name}
+		r.auditLogger.LogWithDetails(OpRemoveRepository, corr, r.system, nil, &team, nil, details, "removed repository '%s' from GitHub team '%s'", name, slug)
+	}
+
+	state.Repositories = managed
+
+	if len(failures) > 0 {
+		return fmt.Errorf("%s: unable to synchronize %d repositories for GitHub team '%s': %s", OpSetRepositoryPermission, len(failures), slug, strings.Join(failures, "; "))
 	}
 
 	return nil
 }
 
+// getRepositoryPermission Get the highest permission the GitHub team has on a repository in the organization, or an
+// empty string if the team has no access to the repository
+func (r *githubTeamReconciler) getRepositoryPermission(ctx context.Context, slug, name string) (string, error) {
+	repository, resp, err := r.teamsService.IsTeamRepoBySlug(ctx, r.org, slug, r.org, name)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if resp == nil && err != nil {
+		return "", err
+	}
+	err = httpError(http.StatusOK, resp, err)
+	if err != nil {
+		return "", err
+	}
+
+	for _, permission := range []string{"admin", "maintain", "push", "triage", "pull"} {
+		if repository.GetPermissions()[permission] {
+			return permission, nil
+		}
+	}
+
+	return "", nil
+}
+
 // getTeamMembers Get all team members in a GitHub team using a paginated query
 func (r *githubTeamReconciler) getTeamMembers(ctx context.Context, slug string) ([]*github.User, error) {
 	const maxPerPage = 100
@@ -188,7 +632,7 @@ func (r *githubTeamReconciler) getTeamMembers(ctx context.Context, slug string)
 	allMembers := make([]*github.User, 0)
 	for {
 		members, resp, err := r.teamsService.ListTeamMembersBySlug(ctx, r.org, slug, opt)
-		err = httpError(http.StatusOK, *resp, err)
+		err = httpError(http.StatusOK, resp, err)
 		if err != nil {
 			return nil, err
 		}
@@ -235,74 +679,165 @@ func remoteOnlyMembers(membersAccordingToGitHub []*github.User, consoleUsers map
 // will be ignored.
 func (r *githubTeamReconciler) mapSSOUsers(ctx context.Context, users []*dbmodels.User) (map[string]*dbmodels.User, error) {
 	userMap := make(map[string]*dbmodels.User)
+	if len(users) == 0 {
+		return userMap, nil
+	}
+
+	err := r.refreshIdentities(ctx, r.identityCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make([]string, 0, len(users))
 	for _, user := range users {
-		githubUsername, err := r.getGitHubUsernameFromEmail(ctx, user.Email)
-		if err == errGitHubUserNotFound {
-			log.Warnf("%s: no GitHub user for email: '%s'", OpMapSSOUser, user.Email)
-			continue
+		emails = append(emails, strings.ToLower(user.Email))
+	}
+
+	usernames, err := r.cachedUsernames(emails)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(usernames) < len(emails) {
+		err = r.refreshIdentities(ctx, identityMissRefreshInterval)
+		if err != nil {
+			return nil, err
 		}
+
+		usernames, err = r.cachedUsernames(emails)
 		if err != nil {
 			return nil, err
 		}
-		userMap[*githubUsername] = user
+	}
+
+	for _, user := range users {
+		githubUsername, exists := usernames[strings.ToLower(user.Email)]
+		if !exists {
+			log.Warnf("%s: no GitHub user for email: '%s'", OpMapSSOUser, user.Email)
+			continue
+		}
+		userMap[githubUsername] = user
 	}
 
 	return userMap, nil
 }
 
-// getGitHubUsernameFromEmail Look up a GitHub username from an SSO e-mail address connected to that user account.
-func (r *githubTeamReconciler) getGitHubUsernameFromEmail(ctx context.Context, email string) (*string, error) {
-	var query LookupGitHubSamlUserByEmail
-
-	variables := map[string]interface{}{
-		"org":      githubv4.String(r.org),
-		"username": githubv4.String(email),
-	}
-
-	err := r.graphClient.Query(ctx, &query, variables)
+// cachedUsernames Get the GitHub usernames of the lowercase email addresses from the cached SAML identities, keyed by
+// email address
+func (r *githubTeamReconciler) cachedUsernames(emails []string) (map[string]string, error) {
+	identities := make([]*dbmodels.ExternalIdentity, 0)
+	err := r.db.Where("system_id = ? AND email IN (?)", r.system.ID, emails).Find(&identities).Error
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%s: get cached SAML identities: %w", OpMapSSOUser, err)
 	}
 
-	nodes := query.Organization.SamlIdentityProvider.ExternalIdentities.Nodes
-	if len(nodes) == 0 {
-		return nil, errGitHubUserNotFound
+	usernames := make(map[string]string, len(identities))
+	for _, identity := range identities {
+		usernames[identity.Email] = identity.Username
 	}
 
-	username := string(nodes[0].User.Login)
-	return &username, nil
+	return usernames, nil
 }
 
-// getEmailFromGitHubUsername Look up a GitHub username from an SSO e-mail address connected to that user account.
-func (r *githubTeamReconciler) getEmailFromGitHubUsername(ctx context.Context, username string) (*string, error) {
-	var query LookupGitHubSamlUserByGitHubUsername
+// refreshIdentities Replace the cached SAML identities with the identities of all members of the organization, unless
+// they were fetched less than maxAge ago. All identities are fetched with a single paginated query.
+func (r *githubTeamReconciler) refreshIdentities(ctx context.Context, maxAge time.Duration) error {
+	r.identityLock.Lock()
+	defer r.identityLock.Unlock()
+
+	updatedAt, err := dbmodels.ExternalIdentitiesUpdatedAt(r.db, *r.system.ID)
+	if err != nil {
+		return err
+	}
+
+	if updatedAt != nil && time.Since(*updatedAt) < maxAge {
+		return nil
+	}
 
+	identities := make(map[string]string)
 	variables := map[string]interface{}{
 		"org":   githubv4.String(r.org),
-		"login": githubv4.String(username),
+		"after": (*githubv4.String)(nil),
 	}
 
-	err := r.graphClient.Query(ctx, &query, variables)
+	for {
+		var query ListGitHubSamlIdentities
+		err = r.graphClient.Query(ctx, &query, variables)
+		if err != nil {
+			return fmt.Errorf("%s: list SAML identities: %w", OpMapSSOUser, err)
+		}
+
+		externalIdentities := query.Organization.SamlIdentityProvider.ExternalIdentities
+		for _, node := range externalIdentities.Nodes {
+			// Identities of users that have not linked a GitHub account have no login
+			if node.User.Login == "" || node.SamlIdentity.Username == "" {
+				continue
+			}
+			identities[strings.ToLower(string(node.SamlIdentity.Username))] = string(node.User.Login)
+		}
+
+		if !externalIdentities.PageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = githubv4.NewString(externalIdentities.PageInfo.EndCursor)
+	}
+
+	err = dbmodels.ReplaceExternalIdentities(r.db, *r.system.ID, identities)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	nodes := query.Organization.SamlIdentityProvider.ExternalIdentities.Nodes
-	if len(nodes) == 0 {
+	log.Infof("%s: cached %d SAML identities", OpMapSSOUser, len(identities))
+
+	return nil
+}
+
+// getEmailFromGitHubUsername Look up the SSO e-mail address connected to a GitHub account in the cached SAML identities
+func (r *githubTeamReconciler) getEmailFromGitHubUsername(username string) (*string, error) {
+	identity := &dbmodels.ExternalIdentity{}
+	err := r.db.Where("system_id = ? AND username = ?", r.system.ID, username).First(identity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, errGitHubUserNotFound
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	email := strings.ToLower(string(nodes[0].SamlIdentity.Username))
-	return &email, nil
+	return &identity.Email, nil
+}
+
+// isNameConflict Check if GitHub refused to create a team because the organization already has a team with the same
+// name
+func isNameConflict(resp *github.Response, err error) bool {
+	if resp == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+
+	errorResponse := &github.ErrorResponse{}
+	if !errors.As(err, &errorResponse) {
+		return false
+	}
+
+	for _, e := range errorResponse.Errors {
+		if e.Code == "already_exists" || strings.Contains(strings.ToLower(e.Message), "must be unique") {
+			return true
+		}
+	}
+
+	return false
 }
 
 // httpError Return an error if the response status code is not as expected, or if the passed err is already set to an
 // error
-func httpError(expected int, resp github.Response, err error) error {
+func httpError(expected int, resp *github.Response, err error) error {
 	if err != nil {
 		return err
 	}
 
+	if resp == nil {
+		return errors.New("no response")
+	}
+
 	if resp.StatusCode != expected {
 		if resp.Body == nil {
 			return errors.New("unknown error")
@@ -13,10 +13,12 @@ import (
 	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 	"io/ioutil"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 )
 
 func modelWithId() dbmodels.Model {
@@ -48,11 +50,11 @@ func TestGitHubReconciler_getOrCreateTeam(t *testing.T) {
 	}
 
 	auditLogger := &auditlogger.MockAuditLogger{}
-	auditLogger.On("Logf", github_team_reconciler.OpCreate, corr, system, mock.Anything, &team, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	auditLogger.On("LogWithDetails", github_team_reconciler.OpCreate, corr, system, mock.Anything, &team, mock.Anything, github_team_reconciler.TeamDetails{TeamSlug: teamSlug}, mock.Anything, mock.Anything).Return(nil)
 
 	t.Run("no existing state, github team available", func(t *testing.T) {
 		db := test.GetTestDB()
-		db.AutoMigrate(&dbmodels.SystemState{})
+		db.AutoMigrate(&dbmodels.SystemState{}, &dbmodels.TeamRepository{})
 		teamsService := github_team_reconciler.NewMockTeamsService(t)
 		teamsService.
 			On(
@@ -114,7 +116,7 @@ func TestGitHubReconciler_getOrCreateTeam(t *testing.T) {
 
 	t.Run("existing state, github team exists", func(t *testing.T) {
 		db := test.GetTestDB()
-		db.AutoMigrate(&dbmodels.SystemState{})
+		db.AutoMigrate(&dbmodels.SystemState{}, &dbmodels.TeamRepository{})
 		dbmodels.SetSystemState(db, *system.ID, *team.ID, reconcilers.GitHubState{Slug: helpers.Strp("existing-slug")})
 
 		teamsService := github_team_reconciler.NewMockTeamsService(t)
@@ -154,7 +156,7 @@ func TestGitHubReconciler_getOrCreateTeam(t *testing.T) {
 
 	t.Run("existing state, github team no longer exists", func(t *testing.T) {
 		db := test.GetTestDB()
-		db.AutoMigrate(&dbmodels.SystemState{})
+		db.AutoMigrate(&dbmodels.SystemState{}, &dbmodels.TeamRepository{})
 		initialState := &reconcilers.GitHubState{Slug: helpers.Strp("existing-slug")}
 		dbmodels.SetSystemState(db, *system.ID, *team.ID, initialState)
 		teamsService := github_team_reconciler.NewMockTeamsService(t)
@@ -209,6 +211,68 @@ func TestGitHubReconciler_getOrCreateTeam(t *testing.T) {
 		dbmodels.LoadSystemState(db, *system.ID, *team.ID, updatedState)
 		assert.Equal(t, "slug", *updatedState.Slug)
 	})
+
+	t.Run("no existing state, github team name is taken", func(t *testing.T) {
+		db := test.GetTestDB()
+		db.AutoMigrate(&dbmodels.SystemState{})
+		teamsService := github_team_reconciler.NewMockTeamsService(t)
+		teamsService.
+			On(
+				"CreateTeam",
+				ctx,
+				org,
+				github.NewTeam{Name: teamSlug, Description: helpers.Strp(teamPurpose)},
+			).
+			Return(
+				nil,
+				&github.Response{Response: &http.Response{StatusCode: http.StatusUnprocessableEntity}},
+				&github.ErrorResponse{Message: "Validation Failed", Errors: []github.Error{{Message: "Name must be unique for this org"}}},
+			).
+			Once()
+
+		reconciler := github_team_reconciler.New(db, system, auditLogger, org, domain, teamsService, github_team_reconciler.NewMockGraphClient(t))
+		err := reconciler.Reconcile(ctx, input)
+		assert.ErrorIs(t, err, dbmodels.ErrReconcileConflict)
+		teamsService.AssertExpectations(t)
+	})
+
+	t.Run("existing state, github team has been renamed", func(t *testing.T) {
+		db := test.GetTestDB()
+		db.AutoMigrate(&dbmodels.SystemState{}, &dbmodels.TeamRepository{})
+		dbmodels.SetSystemState(db, *system.ID, *team.ID, reconcilers.GitHubState{
+			Slug:           helpers.Strp("existing-slug"),
+			ID:             github.Int64(42),
+			OrganizationID: github.Int64(1),
+		})
+
+		teamsService := github_team_reconciler.NewMockTeamsService(t)
+		teamsService.
+			On("GetTeamByID", ctx, int64(1), int64(42)).
+			Return(
+				&github.Team{ID: github.Int64(42), Slug: helpers.Strp("renamed-slug"), Organization: &github.Organization{ID: github.Int64(1)}},
+				&github.Response{Response: &http.Response{StatusCode: http.StatusOK}},
+				nil,
+			).
+			Once()
+		teamsService.
+			On("ListTeamMembersBySlug", mock.Anything, org, "renamed-slug", mock.Anything).
+			Return(
+				[]*github.User{},
+				&github.Response{Response: &http.Response{StatusCode: http.StatusOK}},
+				nil,
+			).
+			Once()
+
+		reconciler := github_team_reconciler.New(db, system, auditLogger, org, domain, teamsService, github_team_reconciler.NewMockGraphClient(t))
+		err := reconciler.Reconcile(ctx, input)
+		assert.NoError(t, err)
+		teamsService.AssertExpectations(t)
+
+		updatedState := &reconcilers.GitHubState{}
+		dbmodels.LoadSystemState(db, *system.ID, *team.ID, updatedState)
+		assert.Equal(t, "renamed-slug", *updatedState.Slug)
+		assert.Equal(t, int64(42), *updatedState.ID)
+	})
 }
 
 func TestGitHubReconciler_Reconcile(t *testing.T) {
@@ -230,7 +294,7 @@ func TestGitHubReconciler_Reconcile(t *testing.T) {
 	ctx := context.Background()
 
 	auditLogger := &auditlogger.MockAuditLogger{}
-	auditLogger.On("Logf", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	auditLogger.On("LogWithDetails", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	system := dbmodels.System{Model: modelWithId(), Name: github_team_reconciler.Name}
 	corr := dbmodels.Correlation{Model: modelWithId()}
@@ -259,17 +323,18 @@ func TestGitHubReconciler_Reconcile(t *testing.T) {
 	// remove members that shouldn't be present, and add members that should.
 	t.Run("create everything from scratch", func(t *testing.T) {
 		db := test.GetTestDB()
-		db.AutoMigrate(&dbmodels.SystemState{})
+		db.AutoMigrate(&dbmodels.SystemState{}, &dbmodels.TeamRepository{}, &dbmodels.ExternalIdentity{})
 		teamsService := github_team_reconciler.NewMockTeamsService(t)
 		graphClient := github_team_reconciler.NewMockGraphClient(t)
 		reconciler := github_team_reconciler.New(db, system, auditLogger, org, domain, teamsService, graphClient)
 
 		configureCreateTeam(teamsService, org, teamName, teamPurpose)
 
-		configureLookupEmail(graphClient, org, removeLogin, removeEmail)
-
-		configureRegisterLoginEmail(graphClient, org, keepEmail, keepLogin)
-		configureRegisterLoginEmail(graphClient, org, createEmail, createLogin)
+		configureListSamlIdentities(graphClient, org, nil, "", map[string]string{
+			keepEmail:   keepLogin,
+			createEmail: createLogin,
+			removeEmail: removeLogin,
+		})
 
 		configureListTeamMembersBySlug(teamsService, org, teamName, keepLogin, removeLogin)
 		configureAddTeamMembershipBySlug(teamsService, org, teamName, createLogin)
@@ -308,51 +373,434 @@ func TestGitHubReconciler_Reconcile(t *testing.T) {
 	})
 }
 
-func configureRegisterLoginEmail(graphClient *github_team_reconciler.MockGraphClient, org string, email string, login string) *mock.Call {
-	return graphClient.On(
-		"Query",
-		mock.Anything,
-		mock.Anything,
-		map[string]interface{}{
-			"org":      githubv4.String(org),
-			"username": githubv4.String(email),
+func TestGitHubReconciler_DetectDrift(t *testing.T) {
+	const (
+		domain       = "example.com"
+		org          = "my-organization"
+		teamSlug     = "myteam"
+		missingLogin = "should-create"
+		missingEmail = "should-create@example.com"
+		keepLogin    = "should-keep"
+		keepEmail    = "should-keep@example.com"
+		extraLogin   = "should-remove"
+	)
+
+	ctx := context.Background()
+	system := dbmodels.System{Model: modelWithId(), Name: github_team_reconciler.Name}
+	team := dbmodels.Team{
+		Model: modelWithId(),
+		Slug:  teamSlug,
+		Name:  "My team",
+		Users: []*dbmodels.User{
+			{Email: missingEmail},
+			{Email: keepEmail},
 		},
-	).
-		Run(
-			func(args mock.Arguments) {
-				query := args.Get(1).(*github_team_reconciler.LookupGitHubSamlUserByEmail)
-				query.Organization.SamlIdentityProvider.ExternalIdentities.Nodes = []github_team_reconciler.ExternalIdentity{
-					{
-						User: github_team_reconciler.GitHubUser{
-							Login: githubv4.String(login),
-						},
-					},
-				}
+	}
+
+	t.Run("no existing state", func(t *testing.T) {
+		db := test.GetTestDB()
+		db.AutoMigrate(&dbmodels.SystemState{})
+		reconciler := github_team_reconciler.New(db, system, nil, org, domain, github_team_reconciler.NewMockTeamsService(t), github_team_reconciler.NewMockGraphClient(t))
+
+		drift, err := reconciler.DetectDrift(ctx, team)
+		assert.NoError(t, err)
+		assert.Len(t, drift, 1)
+		assert.Equal(t, dbmodels.DriftKindMissingResource, drift[0].Kind)
+	})
+
+	t.Run("github team no longer exists", func(t *testing.T) {
+		db := test.GetTestDB()
+		db.AutoMigrate(&dbmodels.SystemState{})
+		dbmodels.SetSystemState(db, *system.ID, *team.ID, reconcilers.GitHubState{Slug: helpers.Strp(teamSlug)})
+
+		teamsService := github_team_reconciler.NewMockTeamsService(t)
+		teamsService.On("GetTeamBySlug", mock.Anything, org, teamSlug).
+			Return(nil, &github.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, &github.ErrorResponse{}).Once()
+		reconciler := github_team_reconciler.New(db, system, nil, org, domain, teamsService, github_team_reconciler.NewMockGraphClient(t))
+
+		drift, err := reconciler.DetectDrift(ctx, team)
+		assert.NoError(t, err)
+		assert.Len(t, drift, 1)
+		assert.Equal(t, dbmodels.DriftKindMissingResource, drift[0].Kind)
+		teamsService.AssertExpectations(t)
+	})
+
+	t.Run("extra and missing members", func(t *testing.T) {
+		db := test.GetTestDB()
+		db.AutoMigrate(&dbmodels.SystemState{}, &dbmodels.ExternalIdentity{})
+		dbmodels.SetSystemState(db, *system.ID, *team.ID, reconcilers.GitHubState{Slug: helpers.Strp(teamSlug)})
+
+		teamsService := github_team_reconciler.NewMockTeamsService(t)
+		graphClient := github_team_reconciler.NewMockGraphClient(t)
+		teamsService.On("GetTeamBySlug", mock.Anything, org, teamSlug).
+			Return(&github.Team{Slug: helpers.Strp(teamSlug), Name: helpers.Strp(teamSlug)}, &github.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil).Once()
+		configureListTeamMembersBySlug(teamsService, org, teamSlug, keepLogin, extraLogin)
+		configureListSamlIdentities(graphClient, org, nil, "", map[string]string{
+			keepEmail:    keepLogin,
+			missingEmail: missingLogin,
+		})
+
+		// The reconciler must not change anything in GitHub, which the mocks would fail on
+		reconciler := github_team_reconciler.New(db, system, nil, org, domain, teamsService, graphClient)
+		drift, err := reconciler.DetectDrift(ctx, team)
+		assert.NoError(t, err)
+		assert.Len(t, drift, 2)
+
+		subjects := make(map[dbmodels.DriftKind]string)
+		for _, d := range drift {
+			subjects[d.Kind] = d.Subject
+		}
+		assert.Equal(t, extraLogin, subjects[dbmodels.DriftKindExtraMember])
+		assert.Equal(t, missingLogin, subjects[dbmodels.DriftKindMissingMember])
+		teamsService.AssertExpectations(t)
+		graphClient.AssertExpectations(t)
+	})
+}
+
+func TestGitHubReconciler_IdentityCache(t *testing.T) {
+	const (
+		domain   = "example.com"
+		org      = "my-organization"
+		teamSlug = "myteam"
+	)
+
+	ctx := context.Background()
+	system := dbmodels.System{Model: modelWithId(), Name: github_team_reconciler.Name}
+	team := dbmodels.Team{
+		Model: modelWithId(),
+		Slug:  teamSlug,
+		Name:  "My team",
+		Users: []*dbmodels.User{
+			{Email: "First@example.com"},
+			{Email: "second@example.com"},
+			{Email: "unlinked@example.com"},
+		},
+	}
+
+	db := test.GetTestDB()
+	db.AutoMigrate(&dbmodels.SystemState{}, &dbmodels.ExternalIdentity{})
+	dbmodels.SetSystemState(db, *system.ID, *team.ID, reconcilers.GitHubState{Slug: helpers.Strp(teamSlug)})
+
+	teamsService := github_team_reconciler.NewMockTeamsService(t)
+	teamsService.On("GetTeamBySlug", mock.Anything, org, teamSlug).
+		Return(&github.Team{Slug: helpers.Strp(teamSlug), Name: helpers.Strp(teamSlug)}, &github.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil).Twice()
+	teamsService.On("ListTeamMembersBySlug", mock.Anything, org, teamSlug, mock.Anything).
+		Return([]*github.User{{Login: helpers.Strp("first")}, {Login: helpers.Strp("second")}}, &github.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil).Twice()
+
+	// All identities are fetched in a single paginated query, which is not repeated while the cache is fresh
+	graphClient := github_team_reconciler.NewMockGraphClient(t)
+	configureListSamlIdentities(graphClient, org, nil, "page-2", map[string]string{
+		"first@example.com": "first",
+		"other@example.com": "other",
+	})
+	configureListSamlIdentities(graphClient, org, githubv4.NewString("page-2"), "", map[string]string{
+		"second@example.com": "second",
+	})
+
+	reconciler := github_team_reconciler.New(db, system, nil, org, domain, teamsService, graphClient)
+	for i := 0; i < 2; i++ {
+		drift, err := reconciler.DetectDrift(ctx, team)
+		assert.NoError(t, err)
+		assert.Empty(t, drift)
+	}
+
+	identities := make([]*dbmodels.ExternalIdentity, 0)
+	db.Find(&identities)
+	assert.Len(t, identities, 3)
+	teamsService.AssertExpectations(t)
+	graphClient.AssertExpectations(t)
+
+	t.Run("members without a cached identity refresh the cache early", func(t *testing.T) {
+		db.Model(&dbmodels.ExternalIdentity{}).Where("1 = 1").UpdateColumn("updated_at", time.Now().Add(-10*time.Minute))
+
+		teamsService.On("GetTeamBySlug", mock.Anything, org, teamSlug).
+			Return(&github.Team{Slug: helpers.Strp(teamSlug), Name: helpers.Strp(teamSlug)}, &github.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil).Once()
+		teamsService.On("ListTeamMembersBySlug", mock.Anything, org, teamSlug, mock.Anything).
+			Return([]*github.User{{Login: helpers.Strp("first")}, {Login: helpers.Strp("second")}}, &github.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil).Once()
+		configureListSamlIdentities(graphClient, org, nil, "", map[string]string{
+			"first@example.com":    "first",
+			"second@example.com":   "second",
+			"unlinked@example.com": "unlinked",
+		})
+
+		drift, err := reconciler.DetectDrift(ctx, team)
+		assert.NoError(t, err)
+		assert.Len(t, drift, 1)
+		assert.Equal(t, "unlinked", drift[0].Subject)
+
+		identities := make([]*dbmodels.ExternalIdentity, 0)
+		db.Order("email").Find(&identities)
+		assert.Len(t, identities, 3)
+		assert.Equal(t, "unlinked@example.com", identities[2].Email)
+		graphClient.AssertExpectations(t)
+	})
+}
+
+func TestGitHubReconciler_Repositories(t *testing.T) {
+	const (
+		domain   = "example.com"
+		org      = "my-organization"
+		teamSlug = "myteam"
+	)
+
+	ctx := context.Background()
+	system := dbmodels.System{Model: modelWithId(), Name: github_team_reconciler.Name}
+	corr := dbmodels.Correlation{Model: modelWithId()}
+	team := dbmodels.Team{
+		Model: modelWithId(),
+		Slug:  teamSlug,
+		Name:  "My team",
+	}
+
+	db := test.GetTestDB()
+	db.AutoMigrate(&dbmodels.SystemState{}, &dbmodels.TeamRepository{})
+	dbmodels.SetSystemState(db, *system.ID, *team.ID, reconcilers.GitHubState{
+		Slug:         helpers.Strp(teamSlug),
+		Repositories: []string{"unchanged", "upgraded", "removed", "remove-fails"},
+	})
+	db.Create(&dbmodels.TeamRepository{TeamID: *team.ID, Name: "unchanged", Permission: dbmodels.RepositoryPermissionWrite})
+	db.Create(&dbmodels.TeamRepository{TeamID: *team.ID, Name: "upgraded", Permission: dbmodels.RepositoryPermissionAdmin})
+	db.Create(&dbmodels.TeamRepository{TeamID: *team.ID, Name: "added", Permission: dbmodels.RepositoryPermissionRead})
+	db.Create(&dbmodels.TeamRepository{TeamID: *team.ID, Name: "misspelled", Permission: dbmodels.RepositoryPermissionRead})
+
+	ok := &github.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+	noContent := &github.Response{Response: &http.Response{StatusCode: http.StatusNoContent}}
+	notFound := &github.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+	forbidden := &github.Response{Response: &http.Response{StatusCode: http.StatusForbidden, Status: "403 Forbidden"}}
+
+	teamsService := github_team_reconciler.NewMockTeamsService(t)
+	teamsService.On("GetTeamBySlug", ctx, org, teamSlug).
+		Return(&github.Team{Slug: helpers.Strp(teamSlug)}, ok, nil).Once()
+	teamsService.On("IsTeamRepoBySlug", ctx, org, teamSlug, org, "unchanged").
+		Return(&github.Repository{Permissions: map[string]bool{"pull": true, "triage": true, "push": true}}, ok, nil).Once()
+	teamsService.On("IsTeamRepoBySlug", ctx, org, teamSlug, org, "upgraded").
+		Return(&github.Repository{Permissions: map[string]bool{"pull": true, "triage": true, "push": true}}, ok, nil).Once()
+	teamsService.On("IsTeamRepoBySlug", ctx, org, teamSlug, org, "added").
+		Return(nil, notFound, nil).Once()
+	teamsService.On("IsTeamRepoBySlug", ctx, org, teamSlug, org, "misspelled").
+		Return(nil, notFound, nil).Once()
+	teamsService.On("AddTeamRepoBySlug", ctx, org, teamSlug, org, "misspelled", &github.TeamAddTeamRepoOptions{Permission: "pull"}).
+		Return(notFound, nil).Once()
+	teamsService.On("AddTeamRepoBySlug", ctx, org, teamSlug, org, "upgraded", &github.TeamAddTeamRepoOptions{Permission: "admin"}).
+		Return(noContent, nil).Once()
+	teamsService.On("AddTeamRepoBySlug", ctx, org, teamSlug, org, "added", &github.TeamAddTeamRepoOptions{Permission: "pull"}).
+		Return(noContent, nil).Once()
+	teamsService.On("RemoveTeamRepoBySlug", ctx, org, teamSlug, org, "removed").
+		Return(noContent, nil).Once()
+	teamsService.On("RemoveTeamRepoBySlug", ctx, org, teamSlug, org, "remove-fails").
+		Return(forbidden, nil).Once()
+	teamsService.On("ListTeamMembersBySlug", ctx, org, teamSlug, mock.Anything).
+		Return([]*github.User{}, ok, nil).Once()
+
+	auditLogger := auditlogger.NewMockAuditLogger(t)
+	auditLogger.On("LogWithDetails", github_team_reconciler.OpSetRepositoryPermission, corr, system, mock.Anything, &team, mock.Anything, github_team_reconciler.RepositoryDetails{TeamSlug: teamSlug, Repository: "upgraded", Permission: "admin"}, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	auditLogger.On("LogWithDetails", github_team_reconciler.OpSetRepositoryPermission, corr, system, mock.Anything, &team, mock.Anything, github_team_reconciler.RepositoryDetails{TeamSlug: teamSlug, Repository: "added", Permission: "pull"}, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	auditLogger.On("LogWithDetails", github_team_reconciler.OpRemoveRepository, corr, system, mock.Anything, &team, mock.Anything, github_team_reconciler.RepositoryDetails{TeamSlug: teamSlug, Repository: "removed"}, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+	reconciler := github_team_reconciler.New(db, system, auditLogger, org, domain, teamsService, github_team_reconciler.NewMockGraphClient(t))
+	err := reconciler.Reconcile(ctx, reconcilers.Input{Corr: corr, Team: team})
+
+	// Failures are reported after the other repositories and the members have been synchronized
+	assert.ErrorContains(t, err, "unable to synchronize 2 repositories")
+	assert.ErrorContains(t, err, "unable to set permission 'pull' on repository 'misspelled'")
+	assert.ErrorContains(t, err, "unable to remove repository 'remove-fails'")
+
+	// Repositories that could not be removed are retried on the next reconcile
+	state := &reconcilers.GitHubState{}
+	dbmodels.LoadSystemState(db, *system.ID, *team.ID, state)
+	assert.ElementsMatch(t, []string{"added", "misspelled", "unchanged", "upgraded", "remove-fails"}, state.Repositories)
+}
+
+func TestGitHubReconciler_ParentTeam(t *testing.T) {
+	const (
+		domain     = "example.com"
+		org        = "my-organization"
+		teamSlug   = "myteam"
+		parentSlug = "nais-teams"
+	)
+
+	ctx := context.Background()
+	system := dbmodels.System{Model: modelWithId(), Name: github_team_reconciler.Name}
+	corr := dbmodels.Correlation{Model: modelWithId()}
+	parentTeam := &github.Team{ID: github.Int64(10), Slug: helpers.Strp(parentSlug)}
+	ok := &github.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	teamWithParent := func(parentSlug string) dbmodels.Team {
+		return dbmodels.Team{
+			Model:   modelWithId(),
+			Slug:    teamSlug,
+			Name:    "My team",
+			Purpose: helpers.Strp("Purpose"),
+			Metadata: []*dbmodels.TeamMetadata{
+				{Key: github_team_reconciler.MetadataParentTeam, Value: helpers.Strp(parentSlug)},
 			},
-		).
-		Once().
-		Return(nil)
+		}
+	}
+
+	setup := func(t *testing.T, team dbmodels.Team, state reconcilers.GitHubState, githubTeam *github.Team) (*gorm.DB, *github_team_reconciler.MockTeamsService) {
+		db := test.GetTestDB()
+		db.AutoMigrate(&dbmodels.SystemState{}, &dbmodels.TeamRepository{})
+		dbmodels.SetSystemState(db, *system.ID, *team.ID, state)
+
+		teamsService := github_team_reconciler.NewMockTeamsService(t)
+		teamsService.On("GetTeamBySlug", ctx, org, teamSlug).Return(githubTeam, ok, nil).Once()
+		teamsService.On("ListTeamMembersBySlug", ctx, org, teamSlug, mock.Anything).Return([]*github.User{}, ok, nil).Once()
+		return db, teamsService
+	}
+
+	t.Run("create team under parent", func(t *testing.T) {
+		db := test.GetTestDB()
+		db.AutoMigrate(&dbmodels.SystemState{}, &dbmodels.TeamRepository{})
+
+		teamsService := github_team_reconciler.NewMockTeamsService(t)
+		teamsService.On("GetTeamBySlug", ctx, org, parentSlug).Return(parentTeam, ok, nil).Once()
+		teamsService.On("CreateTeam", ctx, org, github.NewTeam{Name: teamSlug, Description: helpers.Strp("Purpose"), ParentTeamID: github.Int64(10)}).
+			Return(&github.Team{ID: github.Int64(1), Slug: helpers.Strp(teamSlug), Parent: parentTeam}, &github.Response{Response: &http.Response{StatusCode: http.StatusCreated}}, nil).Once()
+		teamsService.On("ListTeamMembersBySlug", ctx, org, teamSlug, mock.Anything).Return([]*github.User{}, ok, nil).Once()
+
+		auditLogger := auditlogger.NewMockAuditLogger(t)
+		auditLogger.On("LogWithDetails", github_team_reconciler.OpCreate, corr, system, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		team := dbmodels.Team{Model: modelWithId(), Slug: teamSlug, Name: "My team", Purpose: helpers.Strp("Purpose")}
+		reconciler := github_team_reconciler.New(db, system, auditLogger, org, domain, teamsService, github_team_reconciler.NewMockGraphClient(t)).WithParentTeam(parentSlug)
+		err := reconciler.Reconcile(ctx, reconcilers.Input{Corr: corr, Team: team})
+		assert.NoError(t, err)
+
+		state := &reconcilers.GitHubState{}
+		dbmodels.LoadSystemState(db, *system.ID, *team.ID, state)
+		assert.Equal(t, int64(10), *state.ParentID)
+		assert.Equal(t, parentSlug, *state.ParentSlug)
+	})
+
+	t.Run("move existing team under parent", func(t *testing.T) {
+		githubTeam := &github.Team{ID: github.Int64(1), Slug: helpers.Strp(teamSlug), Name: helpers.Strp(teamSlug)}
+		team := dbmodels.Team{Model: modelWithId(), Slug: teamSlug, Name: "My team"}
+		db, teamsService := setup(t, team, reconcilers.GitHubState{Slug: helpers.Strp(teamSlug)}, githubTeam)
+		teamsService.On("GetTeamBySlug", ctx, org, parentSlug).Return(parentTeam, ok, nil).Once()
+		teamsService.On("EditTeamBySlug", ctx, org, teamSlug, github.NewTeam{Name: teamSlug, ParentTeamID: github.Int64(10), Privacy: github.String("closed")}, false).
+			Return(githubTeam, ok, nil).Once()
+
+		auditLogger := auditlogger.NewMockAuditLogger(t)
+		auditLogger.On("LogWithDetails", github_team_reconciler.OpSetParentTeam, corr, system, mock.Anything, mock.Anything, mock.Anything, github_team_reconciler.ParentTeamDetails{TeamSlug: teamSlug, ParentTeamSlug: parentSlug}, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		reconciler := github_team_reconciler.New(db, system, auditLogger, org, domain, teamsService, github_team_reconciler.NewMockGraphClient(t)).WithParentTeam(parentSlug)
+		err := reconciler.Reconcile(ctx, reconcilers.Input{Corr: corr, Team: team})
+		assert.NoError(t, err)
+	})
+
+	t.Run("move existing team under parent team nested below the configured parent", func(t *testing.T) {
+		githubTeam := &github.Team{ID: github.Int64(1), Slug: helpers.Strp(teamSlug), Name: helpers.Strp(teamSlug)}
+		team := teamWithParent("platform")
+		db, teamsService := setup(t, team, reconcilers.GitHubState{Slug: helpers.Strp(teamSlug)}, githubTeam)
+		teamsService.On("GetTeamBySlug", ctx, org, "platform").Return(&github.Team{ID: github.Int64(20), Slug: helpers.Strp("platform"), Parent: parentTeam}, ok, nil).Once()
+		teamsService.On("EditTeamBySlug", ctx, org, teamSlug, github.NewTeam{Name: teamSlug, ParentTeamID: github.Int64(20), Privacy: github.String("closed")}, false).
+			Return(githubTeam, ok, nil).Once()
+
+		auditLogger := auditlogger.NewMockAuditLogger(t)
+		auditLogger.On("LogWithDetails", github_team_reconciler.OpSetParentTeam, corr, system, mock.Anything, mock.Anything, mock.Anything, github_team_reconciler.ParentTeamDetails{TeamSlug: teamSlug, ParentTeamSlug: "platform"}, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		reconciler := github_team_reconciler.New(db, system, auditLogger, org, domain, teamsService, github_team_reconciler.NewMockGraphClient(t)).WithParentTeam(parentSlug)
+		err := reconciler.Reconcile(ctx, reconcilers.Input{Corr: corr, Team: team})
+		assert.NoError(t, err)
+	})
+
+	t.Run("parent team not nested below the configured parent", func(t *testing.T) {
+		githubTeam := &github.Team{ID: github.Int64(1), Slug: helpers.Strp(teamSlug), Name: helpers.Strp(teamSlug), Parent: parentTeam}
+		team := teamWithParent("admins")
+		db, teamsService := setup(t, team, reconcilers.GitHubState{Slug: helpers.Strp(teamSlug), ParentID: github.Int64(10), ParentSlug: helpers.Strp(parentSlug)}, githubTeam)
+		teamsService.On("GetTeamBySlug", ctx, org, "admins").Return(&github.Team{ID: github.Int64(30), Slug: helpers.Strp("admins")}, ok, nil).Once()
+
+		// The team is left below its current parent, and the members are still synchronized
+		reconciler := github_team_reconciler.New(db, system, auditlogger.NewMockAuditLogger(t), org, domain, teamsService, github_team_reconciler.NewMockGraphClient(t)).WithParentTeam(parentSlug)
+		err := reconciler.Reconcile(ctx, reconcilers.Input{Corr: corr, Team: team})
+		assert.ErrorContains(t, err, "parent GitHub team 'admins' is not nested below the configured parent team 'nais-teams'")
+
+		state := &reconcilers.GitHubState{}
+		dbmodels.LoadSystemState(db, *system.ID, *team.ID, state)
+		assert.Equal(t, int64(10), *state.ParentID)
+	})
+
+	t.Run("parent team in metadata without a configured parent", func(t *testing.T) {
+		githubTeam := &github.Team{ID: github.Int64(1), Slug: helpers.Strp(teamSlug), Name: helpers.Strp(teamSlug)}
+		team := teamWithParent(parentSlug)
+		db, teamsService := setup(t, team, reconcilers.GitHubState{Slug: helpers.Strp(teamSlug)}, githubTeam)
+		teamsService.On("GetTeamBySlug", ctx, org, parentSlug).Return(parentTeam, ok, nil).Once()
+
+		reconciler := github_team_reconciler.New(db, system, auditlogger.NewMockAuditLogger(t), org, domain, teamsService, github_team_reconciler.NewMockGraphClient(t))
+		err := reconciler.Reconcile(ctx, reconcilers.Input{Corr: corr, Team: team})
+		assert.ErrorContains(t, err, "no parent team has been configured")
+	})
+
+	t.Run("remove parent set by console", func(t *testing.T) {
+		team := dbmodels.Team{Model: modelWithId(), Slug: teamSlug, Name: "My team"}
+		githubTeam := &github.Team{ID: github.Int64(1), Slug: helpers.Strp(teamSlug), Name: helpers.Strp(teamSlug), Parent: parentTeam}
+		db, teamsService := setup(t, team, reconcilers.GitHubState{Slug: helpers.Strp(teamSlug), ParentID: github.Int64(10), ParentSlug: helpers.Strp(parentSlug)}, githubTeam)
+		teamsService.On("EditTeamBySlug", ctx, org, teamSlug, github.NewTeam{Name: teamSlug}, true).Return(githubTeam, ok, nil).Once()
+
+		auditLogger := auditlogger.NewMockAuditLogger(t)
+		auditLogger.On("LogWithDetails", github_team_reconciler.OpRemoveParentTeam, corr, system, mock.Anything, mock.Anything, mock.Anything, github_team_reconciler.ParentTeamDetails{TeamSlug: teamSlug, ParentTeamSlug: parentSlug}, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		reconciler := github_team_reconciler.New(db, system, auditLogger, org, domain, teamsService, github_team_reconciler.NewMockGraphClient(t))
+		err := reconciler.Reconcile(ctx, reconcilers.Input{Corr: corr, Team: team})
+		assert.NoError(t, err)
+
+		state := &reconcilers.GitHubState{}
+		dbmodels.LoadSystemState(db, *system.ID, *team.ID, state)
+		assert.Nil(t, state.ParentID)
+	})
+
+	t.Run("parent set outside console is left alone", func(t *testing.T) {
+		team := dbmodels.Team{Model: modelWithId(), Slug: teamSlug, Name: "My team"}
+		githubTeam := &github.Team{ID: github.Int64(1), Slug: helpers.Strp(teamSlug), Name: helpers.Strp(teamSlug), Parent: parentTeam}
+		db, teamsService := setup(t, team, reconcilers.GitHubState{Slug: helpers.Strp(teamSlug)}, githubTeam)
+
+		reconciler := github_team_reconciler.New(db, system, auditlogger.NewMockAuditLogger(t), org, domain, teamsService, github_team_reconciler.NewMockGraphClient(t))
+		err := reconciler.Reconcile(ctx, reconcilers.Input{Corr: corr, Team: team})
+		assert.NoError(t, err)
+	})
+
+	t.Run("parent team does not exist", func(t *testing.T) {
+		githubTeam := &github.Team{ID: github.Int64(1), Slug: helpers.Strp(teamSlug), Name: helpers.Strp(teamSlug)}
+		team := teamWithParent("typo")
+		db, teamsService := setup(t, team, reconcilers.GitHubState{Slug: helpers.Strp(teamSlug)}, githubTeam)
+		teamsService.On("GetTeamBySlug", ctx, org, "typo").Return(nil, &github.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, nil).Once()
+
+		reconciler := github_team_reconciler.New(db, system, auditlogger.NewMockAuditLogger(t), org, domain, teamsService, github_team_reconciler.NewMockGraphClient(t)).WithParentTeam(parentSlug)
+		err := reconciler.Reconcile(ctx, reconcilers.Input{Corr: corr, Team: team})
+		assert.ErrorContains(t, err, "parent GitHub team 'typo' does not exist")
+	})
 }
 
-func configureLookupEmail(graphClient *github_team_reconciler.MockGraphClient, org string, login, email string) *mock.Call {
+// configureListSamlIdentities Return a page of SAML identities, which is followed by the page starting at nextCursor
+// unless it is empty
+func configureListSamlIdentities(graphClient *github_team_reconciler.MockGraphClient, org string, after *githubv4.String, nextCursor string, identities map[string]string) *mock.Call {
 	return graphClient.On(
 		"Query",
 		mock.Anything,
-		mock.Anything,
+		mock.AnythingOfType("*github_team_reconciler.ListGitHubSamlIdentities"),
 		map[string]interface{}{
 			"org":   githubv4.String(org),
-			"login": githubv4.String(login),
+			"after": after,
 		},
 	).
 		Run(
 			func(args mock.Arguments) {
-				query := args.Get(1).(*github_team_reconciler.LookupGitHubSamlUserByGitHubUsername)
-				query.Organization.SamlIdentityProvider.ExternalIdentities.Nodes = []github_team_reconciler.ExternalIdentity{
-					{
+				query := args.Get(1).(*github_team_reconciler.ListGitHubSamlIdentities)
+				nodes := make([]github_team_reconciler.ExternalIdentity, 0, len(identities))
+				for email, login := range identities {
+					nodes = append(nodes, github_team_reconciler.ExternalIdentity{
+						User: github_team_reconciler.GitHubUser{
+							Login: githubv4.String(login),
+						},
 						SamlIdentity: github_team_reconciler.ExternalIdentitySamlAttributes{
 							Username: githubv4.String(email),
 						},
-					},
+					})
+				}
+				query.Organization.SamlIdentityProvider.ExternalIdentities.Nodes = nodes
+				query.Organization.SamlIdentityProvider.ExternalIdentities.PageInfo = github_team_reconciler.PageInfo{
+					EndCursor:   githubv4.String(nextCursor),
+					HasNextPage: nextCursor != "",
 				}
 			},
 		).
@@ -7,6 +7,8 @@ import (
 	"github.com/nais/console/pkg/dbmodels"
 	"github.com/shurcooL/githubv4"
 	"gorm.io/gorm"
+	"sync"
+	"time"
 )
 
 type GraphClient interface {
@@ -15,10 +17,15 @@ type GraphClient interface {
 
 type TeamsService interface {
 	AddTeamMembershipBySlug(ctx context.Context, org, slug, user string, opts *github.TeamAddTeamMembershipOptions) (*github.Membership, *github.Response, error)
+	AddTeamRepoBySlug(ctx context.Context, org, slug, owner, repo string, opts *github.TeamAddTeamRepoOptions) (*github.Response, error)
 	CreateTeam(ctx context.Context, org string, team github.NewTeam) (*github.Team, *github.Response, error)
+	EditTeamBySlug(ctx context.Context, org, slug string, team github.NewTeam, removeParent bool) (*github.Team, *github.Response, error)
+	GetTeamByID(ctx context.Context, orgID, teamID int64) (*github.Team, *github.Response, error)
 	GetTeamBySlug(ctx context.Context, org, slug string) (*github.Team, *github.Response, error)
+	IsTeamRepoBySlug(ctx context.Context, org, slug, owner, repo string) (*github.Repository, *github.Response, error)
 	ListTeamMembersBySlug(ctx context.Context, org, slug string, opts *github.TeamListTeamMembersOptions) ([]*github.User, *github.Response, error)
 	RemoveTeamMembershipBySlug(ctx context.Context, org, slug, user string) (*github.Response, error)
+	RemoveTeamRepoBySlug(ctx context.Context, org, slug, owner, repo string) (*github.Response, error)
 }
 
 // githubTeamReconciler creates teams on GitHub and connects users to them.
@@ -30,6 +37,22 @@ type githubTeamReconciler struct {
 	graphClient  GraphClient
 	org          string
 	domain       string
+	installation InstallationTokenSource
+
+	// identityCacheTTL How long to use the cached SAML identities before fetching them again
+	identityCacheTTL time.Duration
+
+	// identityLock Held while refreshing the cached SAML identities, so reconciles and drift scans running at the same
+	// time don't fetch and store them twice
+	identityLock sync.Mutex
+
+	// parentTeam Slug of the GitHub team that teams are created under, unless the team has its own parent team
+	parentTeam string
+}
+
+// InstallationTokenSource Fetches access tokens for the GitHub App installation
+type InstallationTokenSource interface {
+	Token(ctx context.Context) (string, error)
 }
 
 type GitHubUser struct {
@@ -45,22 +68,43 @@ type ExternalIdentity struct {
 	SamlIdentity ExternalIdentitySamlAttributes
 }
 
-type LookupGitHubSamlUserByEmail struct {
-	Organization struct {
-		SamlIdentityProvider struct {
-			ExternalIdentities struct {
-				Nodes []ExternalIdentity
-			} `graphql:"externalIdentities(first: 1, userName: $username)"`
-		}
-	} `graphql:"organization(login: $org)"`
+type PageInfo struct {
+	EndCursor   githubv4.String
+	HasNextPage bool
 }
 
-type LookupGitHubSamlUserByGitHubUsername struct {
+// ListGitHubSamlIdentities A page of the SAML identities of all members of the organization
+type ListGitHubSamlIdentities struct {
 	Organization struct {
 		SamlIdentityProvider struct {
 			ExternalIdentities struct {
-				Nodes []ExternalIdentity
-			} `graphql:"externalIdentities(first: 1, login: $login)"`
+				Nodes    []ExternalIdentity
+				PageInfo PageInfo
+			} `graphql:"externalIdentities(first: 100, after: $after)"`
 		}
 	} `graphql:"organization(login: $org)"`
 }
+
+// TeamDetails Audit log details for actions on a GitHub team
+type TeamDetails struct {
+	TeamSlug string `json:"teamSlug"`
+}
+
+// MemberDetails Audit log details for changes to the members of a GitHub team
+type MemberDetails struct {
+	TeamSlug string `json:"teamSlug"`
+	Username string `json:"username"`
+}
+
+// RepositoryDetails Audit log details for changes to the repository permissions of a GitHub team
+type RepositoryDetails struct {
+	TeamSlug   string `json:"teamSlug"`
+	Repository string `json:"repository"`
+	Permission string `json:"permission,omitempty"`
+}
+
+// ParentTeamDetails Audit log details for changes to the parent of a GitHub team
+type ParentTeamDetails struct {
+	TeamSlug       string `json:"teamSlug"`
+	ParentTeamSlug string `json:"parentTeamSlug"`
+}
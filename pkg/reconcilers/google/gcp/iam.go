@@ -0,0 +1,147 @@
+package google_gcp_reconciler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/reconcilers"
+	"google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	ownerRole = "roles/owner"
+
+	// iamPolicyVersion Request the newest policy version, so that conditional role bindings are kept when the policy
+	// is written back
+	iamPolicyVersion = 3
+
+	// iamPolicyMaxAttempts Number of times the policy is read and written before giving up, when it is changed by
+	// someone else in between
+	iamPolicyMaxAttempts = 5
+)
+
+// desiredBindings IAM role bindings Console should manage in the project of a team in the given environment
+func (r *googleGcpReconciler) desiredBindings(environment string, team dbmodels.Team) []reconcilers.GoogleGcpBinding {
+	// FIXME: Check state to make sure we are generating the correct group name
+	bindings := []reconcilers.GoogleGcpBinding{
+		{Role: ownerRole, Member: fmt.Sprintf("group:%s%s@%s", reconcilers.TeamNamePrefix, team.Slug, r.domain)},
+	}
+	for _, binding := range r.extraBindings[environment] {
+		bindings = append(bindings, reconcilers.GoogleGcpBinding{Role: binding.Role, Member: binding.Member})
+	}
+	return bindings
+}
+
+// setProjectPermissions Converge the IAM policy of the project. Bindings in managed that are no longer desired are
+// removed, and missing desired bindings are added. All other bindings in the policy are left untouched. The policy is
+// read and written back with its etag, and the update is retried if the policy has been changed concurrently.
+// projectName is in the "projects/{ProjectIdOrNumber}" format, and not the project ID
+func (r *googleGcpReconciler) setProjectPermissions(ctx context.Context, svc *cloudresourcemanager.Service, projectName string, managed, desired []reconcilers.GoogleGcpBinding, corr dbmodels.Correlation, team dbmodels.Team) error {
+	for attempt := 1; ; attempt++ {
+		policy, err := svc.Projects.GetIamPolicy(projectName, &cloudresourcemanager.GetIamPolicyRequest{
+			Options: &cloudresourcemanager.GetPolicyOptions{RequestedPolicyVersion: iamPolicyVersion},
+		}).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("get GCP project IAM policy: %w", err)
+		}
+
+		added, removed := updatePolicyBindings(policy, managed, desired)
+		if len(added) == 0 && len(removed) == 0 {
+			return nil
+		}
+
+		_, err = svc.Projects.SetIamPolicy(projectName, &cloudresourcemanager.SetIamPolicyRequest{Policy: policy}).Context(ctx).Do()
+		if googleError, ok := err.(*googleapi.Error); ok && googleError.Code == http.StatusConflict && attempt < iamPolicyMaxAttempts {
+			// The etag no longer matches, read the policy again
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("set GCP project IAM policy: %w", err)
+		}
+
+		for _, binding := range added {
+			details := PermissionsDetails{ProjectName: projectName, Member: binding.Member, Role: binding.Role}
+			r.auditLogger.LogWithDetails(OpAssignPermissions, corr, r.system, nil, &team, nil, details, "assigned role '%s' to '%s' in GCP project '%s'", binding.Role, binding.Member, projectName)
+		}
+		for _, binding := range removed {
+			details := PermissionsDetails{ProjectName: projectName, Member: binding.Member, Role: binding.Role}
+			r.auditLogger.LogWithDetails(OpRevokePermissions, corr, r.system, nil, &team, nil, details, "revoked role '%s' from '%s' in GCP project '%s'", binding.Role, binding.Member, projectName)
+		}
+
+		return nil
+	}
+}
+
+// updatePolicyBindings Remove the managed bindings that are not desired from the policy, and add the desired bindings
+// that are missing. Conditional bindings are never changed. Returns the bindings that were added and removed.
+func updatePolicyBindings(policy *cloudresourcemanager.Policy, managed, desired []reconcilers.GoogleGcpBinding) (added, removed []reconcilers.GoogleGcpBinding) {
+	isDesired := make(map[reconcilers.GoogleGcpBinding]bool)
+	for _, binding := range desired {
+		isDesired[binding] = true
+	}
+
+	for _, binding := range managed {
+		if isDesired[binding] {
+			continue
+		}
+		roleBinding := unconditionalBinding(policy, binding.Role)
+		if roleBinding == nil {
+			continue
+		}
+		members := make([]string, 0, len(roleBinding.Members))
+		for _, member := range roleBinding.Members {
+			if member == binding.Member {
+				continue
+			}
+			members = append(members, member)
+		}
+		if len(members) < len(roleBinding.Members) {
+			roleBinding.Members = members
+			removed = append(removed, binding)
+		}
+	}
+
+	for _, binding := range desired {
+		roleBinding := unconditionalBinding(policy, binding.Role)
+		if roleBinding == nil {
+			roleBinding = &cloudresourcemanager.Binding{Role: binding.Role}
+			policy.Bindings = append(policy.Bindings, roleBinding)
+		}
+		if hasMember(roleBinding, binding.Member) {
+			continue
+		}
+		roleBinding.Members = append(roleBinding.Members, binding.Member)
+		added = append(added, binding)
+	}
+
+	bindings := make([]*cloudresourcemanager.Binding, 0, len(policy.Bindings))
+	for _, binding := range policy.Bindings {
+		if len(binding.Members) > 0 {
+			bindings = append(bindings, binding)
+		}
+	}
+	policy.Bindings = bindings
+
+	return added, removed
+}
+
+func unconditionalBinding(policy *cloudresourcemanager.Policy, role string) *cloudresourcemanager.Binding {
+	for _, binding := range policy.Bindings {
+		if binding.Role == role && binding.Condition == nil {
+			return binding
+		}
+	}
+	return nil
+}
+
+func hasMember(binding *cloudresourcemanager.Binding, member string) bool {
+	for _, m := range binding.Members {
+		if m == member {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+package google_gcp_reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/config"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/reconcilers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/option"
+)
+
+// fakePolicyServer Serves the IAM policy of a single project, and rejects updates with a stale etag
+type fakePolicyServer struct {
+	policy *cloudresourcemanager.Policy
+	etag   int
+	sets   int
+
+	// beforeSet Called before an update is applied, to simulate concurrent changes
+	beforeSet func(policy *cloudresourcemanager.Policy)
+}
+
+func (s *fakePolicyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/v3/projects/123:getIamPolicy":
+		s.policy.Etag = strconv.Itoa(s.etag)
+		json.NewEncoder(w).Encode(s.policy)
+	case "/v3/projects/123:setIamPolicy":
+		req := &cloudresourcemanager.SetIamPolicyRequest{}
+		json.NewDecoder(r.Body).Decode(req)
+		s.sets++
+		if s.beforeSet != nil {
+			s.beforeSet(s.policy)
+			s.beforeSet = nil
+			s.etag++
+		}
+		if req.Policy.Etag != strconv.Itoa(s.etag) {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error":{"code":409,"message":"etag mismatch","status":"ABORTED"}}`))
+			return
+		}
+		s.policy = req.Policy
+		s.etag++
+		json.NewEncoder(w).Encode(s.policy)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *fakePolicyServer) members(role string) []string {
+	members := make([]string, 0)
+	for _, binding := range s.policy.Bindings {
+		if binding.Role == role && binding.Condition == nil {
+			members = append(members, binding.Members...)
+		}
+	}
+	return members
+}
+
+func TestSetProjectPermissions(t *testing.T) {
+	const (
+		projectName = "projects/123"
+		teamGroup   = "group:nais-team-myteam@example.com"
+		platform    = "serviceAccount:platform@nais.iam.gserviceaccount.com"
+		developer   = "user:developer@example.com"
+	)
+
+	ctx := context.Background()
+	systemID := uuid.New()
+	system := dbmodels.System{Model: dbmodels.Model{ID: &systemID}, Name: Name}
+	corr := dbmodels.Correlation{}
+	team := dbmodels.Team{Slug: "myteam"}
+	extraBindings := config.GCPBindings{
+		"prod": {{Role: "roles/viewer", Member: platform}},
+	}
+
+	setup := func(t *testing.T, policy *cloudresourcemanager.Policy) (*fakePolicyServer, *cloudresourcemanager.Service, *auditlogger.MockAuditLogger, *googleGcpReconciler) {
+		server := &fakePolicyServer{policy: policy}
+		httpServer := httptest.NewServer(server)
+		t.Cleanup(httpServer.Close)
+
+		svc, err := cloudresourcemanager.NewService(ctx, option.WithEndpoint(httpServer.URL+"/"), option.WithoutAuthentication())
+		assert.NoError(t, err)
+
+		auditLogger := auditlogger.NewMockAuditLogger(t)
+		reconciler := New(nil, system, auditLogger, "example.com", nil, nil, extraBindings, nil, nil)
+		return server, svc, auditLogger, reconciler
+	}
+
+	t.Run("bindings not managed by console are kept", func(t *testing.T) {
+		conditional := &cloudresourcemanager.Binding{Role: ownerRole, Members: []string{developer}, Condition: &cloudresourcemanager.Expr{Expression: "request.time < timestamp('2030-01-01T00:00:00Z')"}}
+		server, svc, auditLogger, reconciler := setup(t, &cloudresourcemanager.Policy{
+			Version: 3,
+			Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/editor", Members: []string{developer}},
+				conditional,
+			},
+		})
+		auditLogger.On("LogWithDetails", OpAssignPermissions, corr, system, mock.Anything, mock.Anything, mock.Anything, PermissionsDetails{ProjectName: projectName, Member: teamGroup, Role: ownerRole}, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		auditLogger.On("LogWithDetails", OpAssignPermissions, corr, system, mock.Anything, mock.Anything, mock.Anything, PermissionsDetails{ProjectName: projectName, Member: platform, Role: "roles/viewer"}, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		err := reconciler.setProjectPermissions(ctx, svc, projectName, nil, reconciler.desiredBindings("prod", team), corr, team)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{developer}, server.members("roles/editor"))
+		assert.Equal(t, []string{teamGroup}, server.members(ownerRole))
+		assert.Equal(t, []string{platform}, server.members("roles/viewer"))
+		assert.Contains(t, server.policy.Bindings, conditional)
+		assert.Equal(t, int64(3), server.policy.Version)
+	})
+
+	t.Run("bindings no longer desired are removed", func(t *testing.T) {
+		server, svc, auditLogger, reconciler := setup(t, &cloudresourcemanager.Policy{
+			Bindings: []*cloudresourcemanager.Binding{
+				{Role: ownerRole, Members: []string{teamGroup}},
+				{Role: "roles/viewer", Members: []string{platform, developer}},
+			},
+		})
+		auditLogger.On("LogWithDetails", OpRevokePermissions, corr, system, mock.Anything, mock.Anything, mock.Anything, PermissionsDetails{ProjectName: projectName, Member: platform, Role: "roles/viewer"}, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		managed := []reconcilers.GoogleGcpBinding{
+			{Role: ownerRole, Member: teamGroup},
+			{Role: "roles/viewer", Member: platform},
+		}
+		err := reconciler.setProjectPermissions(ctx, svc, projectName, managed, reconciler.desiredBindings("dev", team), corr, team)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{teamGroup}, server.members(ownerRole))
+		assert.Equal(t, []string{developer}, server.members("roles/viewer"))
+	})
+
+	t.Run("policy already converged", func(t *testing.T) {
+		server, svc, _, reconciler := setup(t, &cloudresourcemanager.Policy{
+			Bindings: []*cloudresourcemanager.Binding{
+				{Role: ownerRole, Members: []string{developer, teamGroup}},
+			},
+		})
+
+		err := reconciler.setProjectPermissions(ctx, svc, projectName, nil, reconciler.desiredBindings("dev", team), corr, team)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, server.sets)
+		assert.Equal(t, []string{developer, teamGroup}, server.members(ownerRole))
+	})
+
+	t.Run("policy changed concurrently", func(t *testing.T) {
+		server, svc, auditLogger, reconciler := setup(t, &cloudresourcemanager.Policy{})
+		server.beforeSet = func(policy *cloudresourcemanager.Policy) {
+			policy.Bindings = append(policy.Bindings, &cloudresourcemanager.Binding{Role: "roles/editor", Members: []string{developer}})
+		}
+		auditLogger.On("LogWithDetails", OpAssignPermissions, corr, system, mock.Anything, mock.Anything, mock.Anything, PermissionsDetails{ProjectName: projectName, Member: teamGroup, Role: ownerRole}, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		err := reconciler.setProjectPermissions(ctx, svc, projectName, nil, reconciler.desiredBindings("dev", team), corr, team)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, server.sets)
+		assert.Equal(t, []string{developer}, server.members("roles/editor"))
+		assert.Equal(t, []string{teamGroup}, server.members(ownerRole))
+	})
+}
@@ -29,3 +29,29 @@ func truncate(s string, length int) string {
 	}
 	return s[:length]
 }
+
+// LabelValue Convert a value to a valid GCP label value. Label values can only contain lowercase letters, numbers,
+// underscores and dashes, and can be at most 63 characters long.
+func LabelValue(value string) string {
+	value = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		default:
+			return '-'
+		}
+	}, value)
+
+	return truncate(value, 63)
+}
+
+// BillingAccountName Return the resource name of a billing account, which can be given either as the ID or as the
+// name, for instance `billingAccounts/012345-567890-ABCDEF`
+func BillingAccountName(billingAccount string) string {
+	if strings.HasPrefix(billingAccount, "billingAccounts/") {
+		return billingAccount
+	}
+	return "billingAccounts/" + billingAccount
+}
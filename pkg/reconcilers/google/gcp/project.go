@@ -0,0 +1,188 @@
+package google_gcp_reconciler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/reconcilers"
+	"google.golang.org/api/cloudbilling/v1"
+	"google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/serviceusage/v1"
+)
+
+// servicesBatchSize Maximum number of services that can be enabled with a single request
+const servicesBatchSize = 20
+
+// projectLabels Labels Console sets on the project of a team in the given environment
+func (r *googleGcpReconciler) projectLabels(environment string, team dbmodels.Team) map[string]string {
+	return map[string]string{
+		"team":        LabelValue(string(team.Slug)),
+		"tenant":      LabelValue(r.domain),
+		"environment": LabelValue(environment),
+		"managed-by":  "console",
+	}
+}
+
+// setProjectLabels Add the labels to the project, and update labels with other values. Other labels on the project are
+// left untouched.
+func (r *googleGcpReconciler) setProjectLabels(ctx context.Context, svc *cloudresourcemanager.Service, project *cloudresourcemanager.Project, labels map[string]string, corr dbmodels.Correlation, team dbmodels.Team) error {
+	projectLabels := make(map[string]string)
+	for key, value := range project.Labels {
+		projectLabels[key] = value
+	}
+
+	changed := false
+	for key, value := range labels {
+		if projectLabels[key] != value {
+			projectLabels[key] = value
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	operation, err := svc.Projects.Patch(project.Name, &cloudresourcemanager.Project{Labels: projectLabels}).UpdateMask("labels").Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("unable to update GCP project labels: %w", err)
+	}
+
+	_, err = waitForProjectOperation(ctx, svc, operation)
+	if err != nil {
+		return fmt.Errorf("unable to update GCP project labels: %w", err)
+	}
+	project.Labels = projectLabels
+
+	details := LabelsDetails{ProjectName: project.Name, Labels: labels}
+	r.auditLogger.LogWithDetails(OpSetLabels, corr, r.system, nil, &team, nil, details, "set labels on GCP project '%s'", project.Name)
+
+	return nil
+}
+
+// setBillingAccount Link the billing account configured for the environment to the project. The billing account is
+// stored in the project state once it has been linked.
+func (r *googleGcpReconciler) setBillingAccount(ctx context.Context, svc *cloudbilling.APIService, project *cloudresourcemanager.Project, environment string, projectState *reconcilers.GoogleGcpEnvironmentProject, corr dbmodels.Correlation, team dbmodels.Team) error {
+	billingAccount, configured := r.billingAccounts[environment]
+	if !configured {
+		return nil
+	}
+	billingAccount = BillingAccountName(billingAccount)
+
+	// The billing API uses the project ID, and not the project number
+	name := "projects/" + project.ProjectId
+	info, err := svc.Projects.GetBillingInfo(name).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("unable to get GCP project billing info: %w", err)
+	}
+
+	if info.BillingAccountName != billingAccount {
+		_, err = svc.Projects.UpdateBillingInfo(name, &cloudbilling.ProjectBillingInfo{BillingAccountName: billingAccount}).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("unable to link billing account '%s' to GCP project: %w", billingAccount, err)
+		}
+
+		details := BillingDetails{ProjectName: project.Name, BillingAccount: billingAccount}
+		r.auditLogger.LogWithDetails(OpLinkBillingAccount, corr, r.system, nil, &team, nil, details, "linked billing account '%s' to GCP project '%s'", billingAccount, project.Name)
+	}
+
+	projectState.BillingAccount = billingAccount
+	return nil
+}
+
+// enableServices Enable the configured services in the project. Services that have already been enabled by Console
+// are stored in the project state, and are not enabled again. Services are never disabled.
+func (r *googleGcpReconciler) enableServices(ctx context.Context, svc *serviceusage.Service, project *cloudresourcemanager.Project, projectState *reconcilers.GoogleGcpEnvironmentProject, corr dbmodels.Correlation, team dbmodels.Team) error {
+	enabled := make(map[string]bool)
+	for _, service := range projectState.Services {
+		enabled[service] = true
+	}
+
+	services := make([]string, 0)
+	for _, service := range r.services {
+		if !enabled[service] {
+			services = append(services, service)
+		}
+	}
+
+	for len(services) > 0 {
+		batch := services
+		if len(batch) > servicesBatchSize {
+			batch = batch[:servicesBatchSize]
+		}
+		services = services[len(batch):]
+
+		operation, err := svc.Services.BatchEnable(project.Name, &serviceusage.BatchEnableServicesRequest{ServiceIds: batch}).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("unable to enable services in GCP project: %w", err)
+		}
+
+		_, err = waitForServiceUsageOperation(ctx, svc, operation)
+		if err != nil {
+			return fmt.Errorf("unable to enable services in GCP project: %w", err)
+		}
+
+		projectState.Services = append(projectState.Services, batch...)
+
+		details := ServicesDetails{ProjectName: project.Name, Services: batch}
+		r.auditLogger.LogWithDetails(OpEnableServices, corr, r.system, nil, &team, nil, details, "enabled %d services in GCP project '%s'", len(batch), project.Name)
+	}
+
+	return nil
+}
+
+// waitForOperation Poll a long running operation until it is done. The Resource Manager and Service Usage APIs each
+// have an operation type of their own, so get fetches the current operation, and status returns whether it is done,
+// along with the error of a failed operation.
+func waitForOperation[T any](ctx context.Context, operation T, get func(T) (T, error), status func(T) (bool, error)) (T, error) {
+	for {
+		done, err := status(operation)
+		if err != nil {
+			return operation, fmt.Errorf("operation failed: %w", err)
+		}
+		if done {
+			return operation, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return operation, fmt.Errorf("gave up waiting for operation: %w", ctx.Err())
+		case <-time.After(1 * time.Second): // Make sure not to hammer the Operation API
+		}
+
+		operation, err = get(operation)
+		if err != nil {
+			return operation, fmt.Errorf("unable to poll operation: %w", err)
+		}
+	}
+}
+
+// waitForProjectOperation Poll a Resource Manager operation until it is done
+func waitForProjectOperation(ctx context.Context, svc *cloudresourcemanager.Service, operation *cloudresourcemanager.Operation) (*cloudresourcemanager.Operation, error) {
+	get := func(operation *cloudresourcemanager.Operation) (*cloudresourcemanager.Operation, error) {
+		return svc.Operations.Get(operation.Name).Context(ctx).Do()
+	}
+	status := func(operation *cloudresourcemanager.Operation) (bool, error) {
+		if operation.Error != nil {
+			return true, errors.New(operation.Error.Message)
+		}
+		return operation.Done, nil
+	}
+	return waitForOperation(ctx, operation, get, status)
+}
+
+// waitForServiceUsageOperation Poll a Service Usage operation until it is done
+func waitForServiceUsageOperation(ctx context.Context, svc *serviceusage.Service, operation *serviceusage.Operation) (*serviceusage.Operation, error) {
+	get := func(operation *serviceusage.Operation) (*serviceusage.Operation, error) {
+		return svc.Operations.Get(operation.Name).Context(ctx).Do()
+	}
+	status := func(operation *serviceusage.Operation) (bool, error) {
+		if operation.Error != nil {
+			return true, errors.New(operation.Error.Message)
+		}
+		return operation.Done, nil
+	}
+	return waitForOperation(ctx, operation, get, status)
+}
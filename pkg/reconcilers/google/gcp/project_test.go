@@ -0,0 +1,176 @@
+package google_gcp_reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/reconcilers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/api/cloudbilling/v1"
+	"google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/option"
+	"google.golang.org/api/serviceusage/v1"
+)
+
+// fakeProjectServer Serves the labels, billing info and services of a single project
+type fakeProjectServer struct {
+	labels         map[string]string
+	billingAccount string
+	batches        [][]string
+	patches        int
+}
+
+func (s *fakeProjectServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodPatch && r.URL.Path == "/v3/projects/123":
+		project := &cloudresourcemanager.Project{}
+		json.NewDecoder(r.Body).Decode(project)
+		s.labels = project.Labels
+		s.patches++
+		json.NewEncoder(w).Encode(&cloudresourcemanager.Operation{Done: true})
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/projects/myteam-prod-1234/billingInfo":
+		json.NewEncoder(w).Encode(&cloudbilling.ProjectBillingInfo{BillingAccountName: s.billingAccount})
+	case r.Method == http.MethodPut && r.URL.Path == "/v1/projects/myteam-prod-1234/billingInfo":
+		info := &cloudbilling.ProjectBillingInfo{}
+		json.NewDecoder(r.Body).Decode(info)
+		s.billingAccount = info.BillingAccountName
+		json.NewEncoder(w).Encode(info)
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/projects/123/services:batchEnable":
+		req := &serviceusage.BatchEnableServicesRequest{}
+		json.NewDecoder(r.Body).Decode(req)
+		s.batches = append(s.batches, req.ServiceIds)
+		json.NewEncoder(w).Encode(&serviceusage.Operation{Done: true})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestProjectSetup(t *testing.T) {
+	const billingAccount = "billingAccounts/012345-567890-ABCDEF"
+
+	ctx := context.Background()
+	systemID := uuid.New()
+	system := dbmodels.System{Model: dbmodels.Model{ID: &systemID}, Name: Name}
+	corr := dbmodels.Correlation{}
+	team := dbmodels.Team{Slug: "myteam"}
+
+	setup := func(t *testing.T, services []string) (*fakeProjectServer, *auditlogger.MockAuditLogger, *googleGcpReconciler, option.ClientOption) {
+		server := &fakeProjectServer{}
+		httpServer := httptest.NewServer(server)
+		t.Cleanup(httpServer.Close)
+
+		auditLogger := auditlogger.NewMockAuditLogger(t)
+		billingAccounts := map[string]string{"prod": "012345-567890-ABCDEF"}
+		reconciler := New(nil, system, auditLogger, "nais.io", nil, nil, nil, billingAccounts, services)
+		return server, auditLogger, reconciler, option.WithEndpoint(httpServer.URL + "/")
+	}
+
+	project := func() *cloudresourcemanager.Project {
+		return &cloudresourcemanager.Project{
+			Name:      "projects/123",
+			ProjectId: "myteam-prod-1234",
+			Labels:    map[string]string{"cost-center": "1234", "team": "other"},
+		}
+	}
+
+	t.Run("set labels", func(t *testing.T) {
+		server, auditLogger, reconciler, endpoint := setup(t, nil)
+		svc, _ := cloudresourcemanager.NewService(ctx, endpoint, option.WithoutAuthentication())
+		auditLogger.On("LogWithDetails", OpSetLabels, corr, system, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		project := project()
+		labels := reconciler.projectLabels("prod", team)
+		err := reconciler.setProjectLabels(ctx, svc, project, labels, corr, team)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"cost-center": "1234",
+			"team":        "myteam",
+			"tenant":      "nais-io",
+			"environment": "prod",
+			"managed-by":  "console",
+		}, server.labels)
+
+		err = reconciler.setProjectLabels(ctx, svc, project, labels, corr, team)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, server.patches)
+	})
+
+	t.Run("link billing account", func(t *testing.T) {
+		server, auditLogger, reconciler, endpoint := setup(t, nil)
+		svc, _ := cloudbilling.NewService(ctx, endpoint, option.WithoutAuthentication())
+		auditLogger.On("LogWithDetails", OpLinkBillingAccount, corr, system, mock.Anything, mock.Anything, mock.Anything, BillingDetails{ProjectName: "projects/123", BillingAccount: billingAccount}, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		projectState := &reconcilers.GoogleGcpEnvironmentProject{}
+		err := reconciler.setBillingAccount(ctx, svc, project(), "prod", projectState, corr, team)
+		assert.NoError(t, err)
+		assert.Equal(t, billingAccount, server.billingAccount)
+		assert.Equal(t, billingAccount, projectState.BillingAccount)
+
+		err = reconciler.setBillingAccount(ctx, svc, project(), "prod", projectState, corr, team)
+		assert.NoError(t, err)
+	})
+
+	t.Run("no billing account in environment", func(t *testing.T) {
+		_, _, reconciler, endpoint := setup(t, nil)
+		svc, _ := cloudbilling.NewService(ctx, endpoint, option.WithoutAuthentication())
+
+		projectState := &reconcilers.GoogleGcpEnvironmentProject{}
+		err := reconciler.setBillingAccount(ctx, svc, project(), "dev", projectState, corr, team)
+		assert.NoError(t, err)
+		assert.Empty(t, projectState.BillingAccount)
+	})
+
+	t.Run("enable services", func(t *testing.T) {
+		services := make([]string, 0)
+		for i := 0; i < 25; i++ {
+			services = append(services, uuid.NewString()+".googleapis.com")
+		}
+		server, auditLogger, reconciler, endpoint := setup(t, services)
+		svc, _ := serviceusage.NewService(ctx, endpoint, option.WithoutAuthentication())
+		auditLogger.On("LogWithDetails", OpEnableServices, corr, system, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+
+		projectState := &reconcilers.GoogleGcpEnvironmentProject{Services: append([]string{}, services[:3]...)}
+		err := reconciler.enableServices(ctx, svc, project(), projectState, corr, team)
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{services[3:23], services[23:]}, server.batches)
+		assert.ElementsMatch(t, services, projectState.Services)
+
+		err = reconciler.enableServices(ctx, svc, project(), projectState, corr, team)
+		assert.NoError(t, err)
+		assert.Len(t, server.batches, 2)
+	})
+}
+
+func TestWaitForOperation(t *testing.T) {
+	status := func(operation *serviceusage.Operation) (bool, error) {
+		if operation.Error != nil {
+			return true, errors.New(operation.Error.Message)
+		}
+		return operation.Done, nil
+	}
+
+	t.Run("failed operation", func(t *testing.T) {
+		operation := &serviceusage.Operation{Done: true, Error: &serviceusage.Status{Message: "service not found"}}
+		_, err := waitForOperation(context.Background(), operation, nil, status)
+		assert.ErrorContains(t, err, "service not found")
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		get := func(operation *serviceusage.Operation) (*serviceusage.Operation, error) {
+			t.Fatal("operation polled after the context was canceled")
+			return nil, nil
+		}
+		_, err := waitForOperation(ctx, &serviceusage.Operation{Name: "operations/1"}, get, status)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
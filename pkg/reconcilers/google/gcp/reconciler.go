@@ -6,8 +6,9 @@ import (
 	"fmt"
 	log "github.com/sirupsen/logrus"
 	"io/ioutil"
+	"net/http"
 	"strconv"
-	"time"
+	"strings"
 
 	"github.com/nais/console/pkg/auditlogger"
 	"github.com/nais/console/pkg/config"
@@ -15,8 +16,11 @@ import (
 	"github.com/nais/console/pkg/reconcilers"
 	"golang.org/x/oauth2/google"
 	"golang.org/x/oauth2/jwt"
+	"google.golang.org/api/cloudbilling/v1"
 	"google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
+	"google.golang.org/api/serviceusage/v1"
 	"gorm.io/gorm"
 )
 
@@ -26,22 +30,64 @@ type googleGcpReconciler struct {
 	domain           string
 	auditLogger      auditlogger.AuditLogger
 	projectParentIDs map[string]int64
+	extraBindings    config.GCPBindings
+	billingAccounts  map[string]string
+	services         []string
 	system           dbmodels.System
 }
 
 const (
-	Name                = "google:gcp:project"
-	OpCreateProject     = "google:gcp:project:create-project"
-	OpAssignPermissions = "google:gcp:project:assign-permissions"
+	Name                 = "google:gcp:project"
+	OpCreateProject      = "google:gcp:project:create-project"
+	OpAssignPermissions  = "google:gcp:project:assign-permissions"
+	OpRevokePermissions  = "google:gcp:project:revoke-permissions"
+	OpSetLabels          = "google:gcp:project:set-labels"
+	OpLinkBillingAccount = "google:gcp:project:link-billing-account"
+	OpEnableServices     = "google:gcp:project:enable-services"
 )
 
-func New(db *gorm.DB, system dbmodels.System, auditLogger auditlogger.AuditLogger, domain string, config *jwt.Config, projectParentIDs map[string]int64) *googleGcpReconciler {
+// ProjectDetails Audit log details for a created GCP project
+type ProjectDetails struct {
+	ProjectName string `json:"projectName"`
+	ProjectID   string `json:"projectId"`
+	Environment string `json:"environment"`
+}
+
+// PermissionsDetails Audit log details for IAM permissions assigned to or revoked from a GCP project
+type PermissionsDetails struct {
+	ProjectName string `json:"projectName"`
+	Member      string `json:"member"`
+	Role        string `json:"role"`
+}
+
+// LabelsDetails Audit log details for labels set on a GCP project
+type LabelsDetails struct {
+	ProjectName string            `json:"projectName"`
+	Labels      map[string]string `json:"labels"`
+}
+
+// BillingDetails Audit log details for a billing account linked to a GCP project
+type BillingDetails struct {
+	ProjectName    string `json:"projectName"`
+	BillingAccount string `json:"billingAccount"`
+}
+
+// ServicesDetails Audit log details for services enabled in a GCP project
+type ServicesDetails struct {
+	ProjectName string   `json:"projectName"`
+	Services    []string `json:"services"`
+}
+
+func New(db *gorm.DB, system dbmodels.System, auditLogger auditlogger.AuditLogger, domain string, config *jwt.Config, projectParentIDs map[string]int64, extraBindings config.GCPBindings, billingAccounts map[string]string, services []string) *googleGcpReconciler {
 	return &googleGcpReconciler{
 		db:               db,
 		auditLogger:      auditLogger,
 		domain:           domain,
 		config:           config,
 		projectParentIDs: projectParentIDs,
+		extraBindings:    extraBindings,
+		billingAccounts:  billingAccounts,
+		services:         services,
 		system:           system,
 	}
 }
@@ -64,7 +110,7 @@ func NewFromConfig(db *gorm.DB, cfg *config.Config, system dbmodels.System, audi
 		return nil, fmt.Errorf("initialize google credentials: %w", err)
 	}
 
-	return New(db, system, auditLogger, cfg.TenantDomain, cf, cfg.GCP.ProjectParentIDs), nil
+	return New(db, system, auditLogger, cfg.TenantDomain, cf, cfg.GCP.ProjectParentIDs, cfg.GCP.ExtraBindings, cfg.GCP.BillingAccounts, cfg.GCP.Services), nil
 }
 
 func (r *googleGcpReconciler) Reconcile(ctx context.Context, input reconcilers.Input) error {
@@ -82,34 +128,142 @@ func (r *googleGcpReconciler) Reconcile(ctx context.Context, input reconcilers.I
 		return fmt.Errorf("retrieve cloud resource manager client: %w", err)
 	}
 
+	billingSvc, err := cloudbilling.NewService(ctx, option.WithHTTPClient(client))
+	if err != nil {
+		return fmt.Errorf("retrieve cloud billing client: %w", err)
+	}
+
+	serviceUsageSvc, err := serviceusage.NewService(ctx, option.WithHTTPClient(client))
+	if err != nil {
+		return fmt.Errorf("retrieve service usage client: %w", err)
+	}
+
 	for environment, parentFolderID := range r.projectParentIDs {
-		project, err := r.getOrCreateProject(svc, state, environment, parentFolderID, input.Corr, input.Team)
+		project, err := r.getOrCreateProject(ctx, svc, state, environment, parentFolderID, input.Corr, input.Team)
 		if err != nil {
 			return fmt.Errorf("unable to get or create a GCP project for team '%s' in environment '%s': %w", input.Team.Slug, environment, err)
 		}
-		state.Projects[environment] = reconcilers.GoogleGcpEnvironmentProject{
-			ProjectID:   project.ProjectId,
-			ProjectName: project.Name,
+		projectState := state.Projects[environment]
+		projectState.ProjectID = project.ProjectId
+		projectState.ProjectName = project.Name
+		state.Projects[environment] = projectState
+		r.persistState(input.Team, state)
+
+		// Permissions are converged before anything else, so that the team has access to the project even if one of the
+		// remaining steps fails
+		desired := r.desiredBindings(environment, input.Team)
+		err = r.setProjectPermissions(ctx, svc, project.Name, projectState.Bindings, desired, input.Corr, input.Team)
+		if err != nil {
+			return fmt.Errorf("unable to set group permissions to project '%s' for team '%s' in environment '%s': %w", project.Name, input.Team.Slug, environment, err)
 		}
-		err = dbmodels.SetSystemState(r.db, *r.system.ID, *input.Team.ID, state)
+		projectState.Bindings = desired
+		state.Projects[environment] = projectState
+		r.persistState(input.Team, state)
+
+		// The remaining steps do not depend on each other, so a failing step does not prevent the others from running
+		failures := make([]string, 0)
+		err = r.setProjectLabels(ctx, svc, project, r.projectLabels(environment, input.Team), input.Corr, input.Team)
 		if err != nil {
-			log.Errorf("system state not persisted: %s", err)
+			failures = append(failures, fmt.Sprintf("set labels: %s", err))
 		}
 
-		err = r.setProjectPermissions(svc, project.Name, input.Corr, input.Team)
+		err = r.setBillingAccount(ctx, billingSvc, project, environment, &projectState, input.Corr, input.Team)
 		if err != nil {
-			return fmt.Errorf("unable to set group permissions to project '%s' for team '%s' in environment '%s': %w", project.Name, input.Team.Slug, environment, err)
+			failures = append(failures, fmt.Sprintf("link billing account: %s", err))
+		}
+
+		err = r.enableServices(ctx, serviceUsageSvc, project, &projectState, input.Corr, input.Team)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("enable services: %s", err))
+		}
+
+		state.Projects[environment] = projectState
+		r.persistState(input.Team, state)
+		if len(failures) > 0 {
+			return fmt.Errorf("unable to set up project '%s' for team '%s' in environment '%s': %s", project.Name, input.Team.Slug, environment, strings.Join(failures, "; "))
 		}
 	}
 
 	return nil
 }
 
+func (r *googleGcpReconciler) persistState(team dbmodels.Team, state *reconcilers.GoogleGcpProjectState) {
+	err := dbmodels.SetSystemState(r.db, *r.system.ID, *team.ID, state)
+	if err != nil {
+		log.Errorf("system state not persisted: %s", err)
+	}
+}
+
 func (r *googleGcpReconciler) System() dbmodels.System {
 	return r.system
 }
 
-func (r *googleGcpReconciler) getOrCreateProject(svc *cloudresourcemanager.Service, state *reconcilers.GoogleGcpProjectState, environment string, parentFolderID int64, corr dbmodels.Correlation, team dbmodels.Team) (*cloudresourcemanager.Project, error) {
+// CheckCredentials Fetch an access token for the service account
+func (r *googleGcpReconciler) CheckCredentials(ctx context.Context) error {
+	_, err := r.config.TokenSource(ctx).Token()
+	if err != nil {
+		return fmt.Errorf("unable to fetch Google access token: %w", err)
+	}
+
+	return nil
+}
+
+// DetectDrift Check that the GCP project of a Console team exists in every environment, without making any changes.
+// Projects that have been deleted, or are pending deletion, are reported as missing.
+func (r *googleGcpReconciler) DetectDrift(ctx context.Context, team dbmodels.Team) ([]reconcilers.Drift, error) {
+	state := &reconcilers.GoogleGcpProjectState{
+		Projects: make(map[string]reconcilers.GoogleGcpEnvironmentProject),
+	}
+	err := dbmodels.LoadSystemState(r.db, *r.system.ID, *team.ID, state)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load system state for team '%s' in system '%s': %w", team.Slug, r.system.Name, err)
+	}
+
+	client := r.config.Client(ctx)
+	svc, err := cloudresourcemanager.NewService(ctx, option.WithHTTPClient(client))
+	if err != nil {
+		return nil, fmt.Errorf("retrieve cloud resource manager client: %w", err)
+	}
+
+	drift := make([]reconcilers.Drift, 0)
+	for environment := range r.projectParentIDs {
+		projectID := GenerateProjectID(r.domain, environment, string(team.Slug))
+		missingProject := reconcilers.Drift{
+			Kind:     dbmodels.DriftKindMissingResource,
+			Resource: projectID,
+			Subject:  environment,
+			Message:  fmt.Sprintf("GCP project for team '%s' in environment '%s' does not exist", team.Slug, environment),
+		}
+
+		// Projects that have not been created yet are created when reconciling, while a deleted project is not replaced
+		projectFromState, exists := state.Projects[environment]
+		if !exists {
+			missingProject.Correctable = true
+			drift = append(drift, missingProject)
+			continue
+		}
+
+		missingProject.Resource = projectFromState.ProjectID
+		project, err := svc.Projects.Get(projectFromState.ProjectName).Do()
+		if googleError, ok := err.(*googleapi.Error); ok && (googleError.Code == http.StatusNotFound || googleError.Code == http.StatusForbidden) {
+			// Deleted projects are reported as forbidden once they are no longer visible to the service account
+			drift = append(drift, missingProject)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch GCP project '%s': %w", projectFromState.ProjectName, err)
+		}
+
+		if project.State != "ACTIVE" {
+			missingProject.Message = fmt.Sprintf("GCP project '%s' for team '%s' in environment '%s' is in state '%s'", project.ProjectId, team.Slug, environment, project.State)
+			drift = append(drift, missingProject)
+		}
+	}
+
+	return drift, nil
+}
+
+func (r *googleGcpReconciler) getOrCreateProject(ctx context.Context, svc *cloudresourcemanager.Service, state *reconcilers.GoogleGcpProjectState, environment string, parentFolderID int64, corr dbmodels.Correlation, team dbmodels.Team) (*cloudresourcemanager.Project, error) {
 	if projectFromState, exists := state.Projects[environment]; exists {
 		project, err := svc.Projects.Get(projectFromState.ProjectName).Do()
 		if err == nil {
@@ -122,22 +276,16 @@ func (r *googleGcpReconciler) getOrCreateProject(svc *cloudresourcemanager.Servi
 		DisplayName: team.Name,
 		Parent:      "folders/" + strconv.FormatInt(parentFolderID, 10),
 		ProjectId:   projectId,
+		Labels:      r.projectLabels(environment, team),
 	}
 	operation, err := svc.Projects.Create(project).Do()
 	if err != nil {
 		return nil, fmt.Errorf("unable to create GCP project: %w", err)
 	}
 
-	for !operation.Done {
-		time.Sleep(1 * time.Second) // Make sure not to hammer the Operation API
-		operation, err = svc.Operations.Get(operation.Name).Do()
-		if err != nil {
-			return nil, fmt.Errorf("unable to poll GCP project creation: %w", err)
-		}
-	}
-
-	if operation.Error != nil {
-		return nil, fmt.Errorf("unable to create GCP project: %s", operation.Error.Message)
+	operation, err = waitForProjectOperation(ctx, svc, operation)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create GCP project: %w", err)
 	}
 
 	createdProject := &cloudresourcemanager.Project{}
@@ -146,36 +294,8 @@ func (r *googleGcpReconciler) getOrCreateProject(svc *cloudresourcemanager.Servi
 		return nil, fmt.Errorf("unable to convert operation response to the created GCP project: %w", err)
 	}
 
-	r.auditLogger.Logf(OpCreateProject, corr, r.system, nil, &team, nil, "created GCP project '%s' for team '%s' in environment '%s'", createdProject.Name, team.Slug, environment)
+	details := ProjectDetails{ProjectName: createdProject.Name, ProjectID: createdProject.ProjectId, Environment: environment}
+	r.auditLogger.LogWithDetails(OpCreateProject, corr, r.system, nil, &team, nil, details, "created GCP project '%s' for team '%s' in environment '%s'", createdProject.Name, team.Slug, environment)
 
 	return createdProject, nil
 }
-
-// createPermissions Give owner permissions to the team group. The group is created by the Google Workspace Admin
-// reconciler. projectName is in the "projects/{ProjectIdOrNumber}" format, and not the project ID
-func (r *googleGcpReconciler) setProjectPermissions(svc *cloudresourcemanager.Service, projectName string, corr dbmodels.Correlation, team dbmodels.Team) error {
-	// FIXME: Check state to make sure we are generating the correct group name
-	member := fmt.Sprintf("group:%s%s@%s", reconcilers.TeamNamePrefix, team.Slug, r.domain)
-	const owner = "roles/owner"
-
-	req := &cloudresourcemanager.SetIamPolicyRequest{
-		Policy: &cloudresourcemanager.Policy{
-			Bindings: []*cloudresourcemanager.Binding{
-				{
-					Members: []string{member},
-					Role:    owner,
-				},
-			},
-		},
-	}
-
-	// replace all existing policies for the project
-	_, err := svc.Projects.SetIamPolicy(projectName, req).Do()
-	if err != nil {
-		return fmt.Errorf("assign GCP project IAM permissions: %w", err)
-	}
-
-	r.auditLogger.Logf(OpAssignPermissions, corr, r.system, nil, &team, nil, "assigned GCP project IAM permissions for '%s'", projectName)
-
-	return nil
-}
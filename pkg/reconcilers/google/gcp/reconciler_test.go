@@ -1,6 +1,7 @@
 package google_gcp_reconciler_test
 
 import (
+	"strings"
 	"testing"
 
 	google_gcp_reconciler "github.com/nais/console/pkg/reconcilers/google/gcp"
@@ -20,3 +21,15 @@ func TestCreateProjectName(t *testing.T) {
 	assert.Equal(t, "happyteam-is-very-ha-prod-4b2d", google_gcp_reconciler.GenerateProjectID("bais.io", "production", "happyteam-is-very-happy"))
 	assert.Equal(t, "happyteam-is-very-ha-prod-4801", google_gcp_reconciler.GenerateProjectID("bais.io", "production", "happyteam-is-very-happy-and-altogether-too-long"))
 }
+
+func TestLabelValue(t *testing.T) {
+	assert.Equal(t, "myteam", google_gcp_reconciler.LabelValue("myteam"))
+	assert.Equal(t, "nais-io", google_gcp_reconciler.LabelValue("nais.io"))
+	assert.Equal(t, "prod-gcp", google_gcp_reconciler.LabelValue("Prod GCP"))
+	assert.Len(t, google_gcp_reconciler.LabelValue(strings.Repeat("a", 100)), 63)
+}
+
+func TestBillingAccountName(t *testing.T) {
+	assert.Equal(t, "billingAccounts/012345-567890-ABCDEF", google_gcp_reconciler.BillingAccountName("012345-567890-ABCDEF"))
+	assert.Equal(t, "billingAccounts/012345-567890-ABCDEF", google_gcp_reconciler.BillingAccountName("billingAccounts/012345-567890-ABCDEF"))
+}
@@ -36,6 +36,19 @@ const (
 	OpAddToGKESecurityGroup = "google:workspace-admin:add-to-gke-security-group"
 )
 
+// GroupDetails Audit log details for actions on a Google Directory group
+type GroupDetails struct {
+	GroupID    string `json:"groupId"`
+	GroupEmail string `json:"groupEmail"`
+}
+
+// MemberDetails Audit log details for changes to the members of a Google Directory group
+type MemberDetails struct {
+	GroupID     string `json:"groupId,omitempty"`
+	GroupEmail  string `json:"groupEmail"`
+	MemberEmail string `json:"memberEmail"`
+}
+
 func New(db *gorm.DB, system dbmodels.System, auditLogger auditlogger.AuditLogger, domain string, config *jwt.Config) *googleWorkspaceAdminReconciler {
 	return &googleWorkspaceAdminReconciler{
 		auditLogger: auditLogger,
@@ -95,6 +108,90 @@ func (r *googleWorkspaceAdminReconciler) System() dbmodels.System {
 	return r.system
 }
 
+// CheckCredentials Fetch an access token for the service account, on behalf of the delegated user
+func (r *googleWorkspaceAdminReconciler) CheckCredentials(ctx context.Context) error {
+	_, err := r.config.TokenSource(ctx).Token()
+	if err != nil {
+		return fmt.Errorf("unable to fetch Google access token: %w", err)
+	}
+
+	return nil
+}
+
+// DetectDrift Compare the Google Directory group of a Console team with the members of the Console team, without
+// making any changes
+func (r *googleWorkspaceAdminReconciler) DetectDrift(ctx context.Context, team dbmodels.Team) ([]reconcilers.Drift, error) {
+	state := &reconcilers.GoogleWorkspaceState{}
+	err := dbmodels.LoadSystemState(r.db, *r.system.ID, *team.ID, state)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load system state for team '%s' in system '%s': %w", team.Slug, r.system.Name, err)
+	}
+
+	email := fmt.Sprintf("%s@%s", reconcilers.TeamNamePrefix+team.Slug, r.domain)
+	missingGroup := []reconcilers.Drift{{
+		Kind:        dbmodels.DriftKindMissingResource,
+		Resource:    email,
+		Message:     fmt.Sprintf("Google Directory group for team '%s' does not exist", team.Slug),
+		Correctable: true,
+	}}
+	if state.GroupID == nil {
+		return missingGroup, nil
+	}
+
+	client := r.config.Client(ctx)
+	srv, err := admin_directory_v1.NewService(ctx, option.WithHTTPClient(client))
+	if err != nil {
+		return nil, fmt.Errorf("retrieve directory client: %w", err)
+	}
+
+	grp, err := srv.Groups.Get(*state.GroupID).Do()
+	if googleError, ok := err.(*googleapi.Error); ok && googleError.Code == http.StatusNotFound {
+		return missingGroup, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch Google Directory group '%s': %w", *state.GroupID, err)
+	}
+
+	drift := make([]reconcilers.Drift, 0)
+	if !strings.EqualFold(grp.Email, email) {
+		drift = append(drift, reconcilers.Drift{
+			Kind:     dbmodels.DriftKindRenamed,
+			Resource: email,
+			Subject:  grp.Email,
+			Message:  fmt.Sprintf("Google Directory group '%s' has email '%s', expected '%s'", grp.Id, grp.Email, email),
+		})
+	}
+
+	membersAccordingToGoogle, err := srv.Members.List(grp.Id).Do()
+	if err != nil {
+		return nil, fmt.Errorf("list existing members in Google Directory group: %w", err)
+	}
+
+	localMembers := helpers.DomainUsers(team.Users, r.domain)
+	for _, member := range remoteOnlyMembers(membersAccordingToGoogle.Members, localMembers) {
+		memberEmail := strings.ToLower(member.Email)
+		drift = append(drift, reconcilers.Drift{
+			Kind:        dbmodels.DriftKindExtraMember,
+			Resource:    grp.Email,
+			Subject:     memberEmail,
+			Message:     fmt.Sprintf("'%s' is a member of Google Directory group '%s', but not of the team", memberEmail, grp.Email),
+			Correctable: true,
+		})
+	}
+
+	for _, user := range localOnlyMembers(membersAccordingToGoogle.Members, localMembers) {
+		drift = append(drift, reconcilers.Drift{
+			Kind:        dbmodels.DriftKindMissingMember,
+			Resource:    grp.Email,
+			Subject:     user.Email,
+			Message:     fmt.Sprintf("'%s' is a member of the team, but not of Google Directory group '%s'", user.Email, grp.Email),
+			Correctable: true,
+		})
+	}
+
+	return drift, nil
+}
+
 func (r *googleWorkspaceAdminReconciler) getOrCreateGroup(groupsService *admin_directory_v1.GroupsService, state *reconcilers.GoogleWorkspaceState, corr dbmodels.Correlation, team dbmodels.Team) (*admin_directory_v1.Group, error) {
 	if state.GroupID != nil {
 		existingGroup, err := groupsService.Get(*state.GroupID).Do()
@@ -115,7 +212,8 @@ func (r *googleWorkspaceAdminReconciler) getOrCreateGroup(groupsService *admin_d
 		return nil, fmt.Errorf("unable to create Google Directory group: %w", err)
 	}
 
-	r.auditLogger.Logf(OpCreate, corr, r.system, nil, &team, nil, "created Google Directory group '%s'", group.Email)
+	details := GroupDetails{GroupID: group.Id, GroupEmail: group.Email}
+	r.auditLogger.LogWithDetails(OpCreate, corr, r.system, nil, &team, nil, details, "created Google Directory group '%s'", group.Email)
 
 	return group, nil
 }
@@ -142,7 +240,8 @@ func (r *googleWorkspaceAdminReconciler) connectUsers(membersService *admin_dire
 			consoleUserMap[remoteMemberEmail] = dbmodels.GetUserByEmail(r.db, remoteMemberEmail)
 		}
 
-		r.auditLogger.Logf(OpDeleteMember, corr, r.system, nil, &team, consoleUserMap[remoteMemberEmail], "deleted member '%s' from Google Directory group '%s'", member.Email, grp.Email)
+		details := MemberDetails{GroupID: grp.Id, GroupEmail: grp.Email, MemberEmail: member.Email}
+		r.auditLogger.LogWithDetails(OpDeleteMember, corr, r.system, nil, &team, consoleUserMap[remoteMemberEmail], details, "deleted member '%s' from Google Directory group '%s'", member.Email, grp.Email)
 	}
 
 	membersToAdd := localOnlyMembers(membersAccordingToGoogle.Members, localMembers)
@@ -155,7 +254,8 @@ func (r *googleWorkspaceAdminReconciler) connectUsers(membersService *admin_dire
 			log.Warnf("%s: add member '%s' to Google Directory group '%s': %s", OpAddMember, member.Email, grp.Email, err)
 			continue
 		}
-		r.auditLogger.Logf(OpAddMember, corr, r.system, nil, &team, user, "added member '%s' to Google Directory group '%s'", member.Email, grp.Email)
+		details := MemberDetails{GroupID: grp.Id, GroupEmail: grp.Email, MemberEmail: member.Email}
+		r.auditLogger.LogWithDetails(OpAddMember, corr, r.system, nil, &team, user, details, "added member '%s' to Google Directory group '%s'", member.Email, grp.Email)
 	}
 
 	return nil
@@ -178,7 +278,8 @@ func (r *googleWorkspaceAdminReconciler) addToGKESecurityGroup(membersService *a
 		return fmt.Errorf("%s: add group '%s' to GKE security group '%s': %s", OpAddToGKESecurityGroup, member.Email, groupKey, err)
 	}
 
-	r.auditLogger.Logf(OpAddToGKESecurityGroup, corr, r.system, nil, &team, nil, "added group '%s' to GKE security group '%s'", member.Email, groupKey)
+	details := MemberDetails{GroupEmail: groupKey, MemberEmail: member.Email}
+	r.auditLogger.LogWithDetails(OpAddToGKESecurityGroup, corr, r.system, nil, &team, nil, details, "added group '%s' to GKE security group '%s'", member.Email, groupKey)
 
 	return nil
 }
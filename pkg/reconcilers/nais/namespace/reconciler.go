@@ -30,6 +30,13 @@ type naisdRequest struct {
 	Data naisdData `json:"data"`
 }
 
+// NamespaceDetails Audit log details for a requested namespace
+type NamespaceDetails struct {
+	Namespace   string `json:"namespace"`
+	Environment string `json:"environment"`
+	ProjectID   string `json:"projectId"`
+}
+
 type naisNamespaceReconciler struct {
 	db               *gorm.DB
 	config           *jwt.Config
@@ -95,7 +102,8 @@ func (r *naisNamespaceReconciler) Reconcile(ctx context.Context, input reconcile
 		}
 
 		// FIXME: Don't create a log entry if the namespace already exists
-		r.auditLogger.Logf(OpCreateNamespace, input.Corr, r.system, nil, &input.Team, nil, "request namespace creation for team '%s' in namespace '%s'", input.Team.Slug, environment)
+		details := NamespaceDetails{Namespace: string(input.Team.Slug), Environment: environment, ProjectID: project.ProjectID}
+		r.auditLogger.LogWithDetails(OpCreateNamespace, input.Corr, r.system, nil, &input.Team, nil, details, "request namespace creation for team '%s' in namespace '%s'", input.Team.Slug, environment)
 	}
 
 	return nil
@@ -17,3 +17,9 @@ type Reconciler interface {
 
 // TeamNamePrefix Prefix that can be used for team-like objects in external systems
 const TeamNamePrefix = "nais-team-"
+
+// CredentialChecker Implemented by reconcilers that can verify their credentials against the external system, for
+// instance by fetching an access token
+type CredentialChecker interface {
+	CheckCredentials(ctx context.Context) error
+}
@@ -7,7 +7,13 @@ type AzureState struct {
 }
 
 type GitHubState struct {
-	Slug *string `json:"slug"`
+	Slug           *string  `json:"slug"`
+	ID             *int64   `json:"id"`             // Used to find the GitHub team when it has been renamed outside Console
+	OrganizationID *int64   `json:"organizationId"` // Organization of the GitHub team, required to look up the team by ID
+	Name           *string  `json:"name"`           // Name of the GitHub team to create, when it should not be the team slug
+	ParentID       *int64   `json:"parentId"`       // Parent team set by Console, if any
+	ParentSlug     *string  `json:"parentSlug"`     // Slug of the parent team set by Console, if any
+	Repositories   []string `json:"repositories"`   // Repositories the GitHub team has been granted access to by Console
 }
 
 type GoogleWorkspaceState struct {
@@ -19,6 +25,14 @@ type GoogleGcpProjectState struct {
 }
 
 type GoogleGcpEnvironmentProject struct {
-	ProjectID   string `json:"projectId"`   // Unique of the project, for instance `my-project-123`
-	ProjectName string `json:"projectName"` // Unique project name, for instance `projects/<int>`
+	ProjectID      string             `json:"projectId"`      // Unique of the project, for instance `my-project-123`
+	ProjectName    string             `json:"projectName"`    // Unique project name, for instance `projects/<int>`
+	Bindings       []GoogleGcpBinding `json:"bindings"`       // IAM role bindings in the project managed by Console
+	BillingAccount string             `json:"billingAccount"` // Billing account linked to the project by Console, for instance `billingAccounts/<id>`
+	Services       []string           `json:"services"`       // Services enabled in the project by Console
+}
+
+type GoogleGcpBinding struct {
+	Role   string `json:"role"`
+	Member string `json:"member"`
 }
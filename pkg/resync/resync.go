@@ -0,0 +1,167 @@
+package resync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/config"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/reconcilers"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// OpResync Operation of the correlation shared by all teams in a full resync
+const OpResync = "resync:all-teams"
+
+var (
+	ErrNotEnabled = errors.New("disabled by configuration")
+)
+
+type resyncer struct {
+	db       *gorm.DB
+	schedule Schedule
+	window   time.Duration
+	jitter   func(window time.Duration) time.Duration
+}
+
+// scheduledTeam A team that will be enqueued for reconciliation after a delay
+type scheduledTeam struct {
+	teamID uuid.UUID
+	delay  time.Duration
+}
+
+// New Create a resyncer that enqueues all teams according to the schedule. Teams are spread randomly over the window,
+// so external systems are not hit by all teams at once.
+func New(db *gorm.DB, schedule Schedule, window time.Duration) *resyncer {
+	return &resyncer{
+		db:       db,
+		schedule: schedule,
+		window:   window,
+		jitter:   randomJitter,
+	}
+}
+
+func NewFromConfig(cfg *config.Config, db *gorm.DB) (*resyncer, error) {
+	if cfg.Resync.Interval == 0 && cfg.Resync.Schedule == "" {
+		return nil, ErrNotEnabled
+	}
+
+	if cfg.Resync.Interval != 0 && cfg.Resync.Schedule != "" {
+		return nil, fmt.Errorf("set either a resync interval or a resync schedule, not both")
+	}
+
+	if cfg.Resync.Window < 0 {
+		return nil, fmt.Errorf("the resync window must not be negative")
+	}
+
+	var schedule Schedule
+	if cfg.Resync.Interval != 0 {
+		if cfg.Resync.Interval < 0 {
+			return nil, fmt.Errorf("the resync interval must be positive")
+		}
+		if cfg.Resync.Window > cfg.Resync.Interval {
+			return nil, fmt.Errorf("the resync window must not be longer than the resync interval")
+		}
+		schedule = Every(cfg.Resync.Interval)
+	} else {
+		var err error
+		schedule, err = ParseCron(cfg.Resync.Schedule)
+		if err != nil {
+			return nil, err
+		}
+		if schedule.Next(time.Now()).IsZero() {
+			return nil, fmt.Errorf("resync schedule '%s' never matches", cfg.Resync.Schedule)
+		}
+	}
+
+	return New(db, schedule, cfg.Resync.Window), nil
+}
+
+// Next Return the time of the next full resync after t
+func (r *resyncer) Next(t time.Time) time.Time {
+	return r.schedule.Next(t)
+}
+
+// Run Start a full resync. All teams share a single correlation, and are sent to teamReconciler at random times within
+// the window. Each team is loaded right before it is sent, so changes made while the resync is in progress are not
+// reverted. Returns the number of teams scheduled; sending continues in the background until done or ctx is canceled.
+func (r *resyncer) Run(ctx context.Context, teamReconciler chan<- reconcilers.Input) (int, error) {
+	teamIDs := make([]uuid.UUID, 0)
+	err := r.db.WithContext(ctx).Model(&dbmodels.Team{}).Pluck("id", &teamIDs).Error
+	if err != nil {
+		return 0, fmt.Errorf("get teams: %w", err)
+	}
+
+	corr := &dbmodels.Correlation{
+		Trigger:   dbmodels.CorrelationTriggerResync,
+		Operation: OpResync,
+	}
+	err = r.db.WithContext(ctx).Create(corr).Error
+	if err != nil {
+		return 0, fmt.Errorf("create correlation for resync: %w", err)
+	}
+
+	scheduled := make([]scheduledTeam, len(teamIDs))
+	for i, teamID := range teamIDs {
+		scheduled[i] = scheduledTeam{
+			teamID: teamID,
+			delay:  r.jitter(r.window),
+		}
+	}
+	sort.Slice(scheduled, func(i, j int) bool {
+		return scheduled[i].delay < scheduled[j].delay
+	})
+
+	go r.enqueue(ctx, *corr, scheduled, teamReconciler)
+
+	return len(scheduled), nil
+}
+
+// enqueue Send the scheduled teams to teamReconciler, sorted by delay, each at its own time
+func (r *resyncer) enqueue(ctx context.Context, corr dbmodels.Correlation, scheduled []scheduledTeam, teamReconciler chan<- reconcilers.Input) {
+	start := time.Now()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for _, entry := range scheduled {
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(time.Until(start.Add(entry.delay)))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		team := &dbmodels.Team{}
+		err := r.db.WithContext(ctx).Preload("Users").Preload("Metadata").Where("id = ?", entry.teamID).First(team).Error
+		if err != nil {
+			log.Warnf("unable to load team '%s' for resync: %s", entry.teamID, err)
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case teamReconciler <- reconcilers.Input{Corr: corr, Team: *team}:
+		}
+	}
+}
+
+func randomJitter(window time.Duration) time.Duration {
+	if window <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(window)))
+}
@@ -0,0 +1,91 @@
+package resync_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nais/console/pkg/config"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/reconcilers"
+	"github.com/nais/console/pkg/resync"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	db := test.GetTestDB()
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Team{}, &dbmodels.UserTeam{}, &dbmodels.TeamMetadata{}, &dbmodels.Correlation{})
+
+	user := &dbmodels.User{Email: "user@example.com", Name: "User"}
+	db.Create(user)
+	for _, slug := range []dbmodels.Slug{"team-a", "team-b", "team-c"} {
+		team := &dbmodels.Team{Slug: slug, Name: string(slug)}
+		db.Create(team)
+		db.Create(&dbmodels.UserTeam{UserID: *user.ID, TeamID: *team.ID})
+	}
+
+	const window = 50 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	teamReconciler := make(chan reconcilers.Input, 10)
+	start := time.Now()
+	teams, err := resync.New(db, resync.Every(time.Hour), window).Run(ctx, teamReconciler)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, teams)
+
+	inputs := make([]reconcilers.Input, 0)
+	for len(inputs) < teams {
+		select {
+		case input := <-teamReconciler:
+			inputs = append(inputs, input)
+		case <-time.After(time.Second):
+			t.Fatal("teams were not scheduled within the window")
+		}
+	}
+	assert.Less(t, time.Since(start), window+500*time.Millisecond)
+
+	slugs := make(map[dbmodels.Slug]bool)
+	for _, input := range inputs {
+		slugs[input.Team.Slug] = true
+		assert.Equal(t, *inputs[0].Corr.ID, *input.Corr.ID)
+		assert.Equal(t, dbmodels.CorrelationTriggerResync, input.Corr.Trigger)
+		assert.Len(t, input.Team.Users, 1)
+	}
+	assert.Len(t, slugs, 3)
+}
+
+func TestNewFromConfig(t *testing.T) {
+	db := test.GetTestDB()
+
+	t.Run("disabled", func(t *testing.T) {
+		_, err := resync.NewFromConfig(config.Defaults(), db)
+		assert.ErrorIs(t, err, resync.ErrNotEnabled)
+	})
+
+	t.Run("interval and schedule", func(t *testing.T) {
+		cfg := config.Defaults()
+		cfg.Resync.Interval = 6 * time.Hour
+		cfg.Resync.Schedule = "0 3 * * *"
+		_, err := resync.NewFromConfig(cfg, db)
+		assert.Error(t, err)
+	})
+
+	t.Run("window longer than interval", func(t *testing.T) {
+		cfg := config.Defaults()
+		cfg.Resync.Interval = 10 * time.Minute
+		_, err := resync.NewFromConfig(cfg, db)
+		assert.Error(t, err)
+	})
+
+	t.Run("schedule", func(t *testing.T) {
+		cfg := config.Defaults()
+		cfg.Resync.Schedule = "0 3 * * *"
+		resyncer, err := resync.NewFromConfig(cfg, db)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, resyncer.Next(time.Now()).Hour())
+	})
+}
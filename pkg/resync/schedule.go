@@ -0,0 +1,188 @@
+package resync
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule Decides when full resyncs run
+type Schedule interface {
+	// Next Return the first time after t a resync should run
+	Next(t time.Time) time.Time
+}
+
+type intervalSchedule struct {
+	interval time.Duration
+}
+
+// Every Run a resync at a fixed interval
+func Every(interval time.Duration) Schedule {
+	return &intervalSchedule{interval: interval}
+}
+
+func (s *intervalSchedule) Next(t time.Time) time.Time {
+	return t.Add(s.interval)
+}
+
+// cronSchedule A standard five field cron expression: minute, hour, day of month, month and day of week
+type cronSchedule struct {
+	minutes     map[int]bool
+	hours       map[int]bool
+	daysOfMonth map[int]bool
+	months      map[int]bool
+	daysOfWeek  map[int]bool
+
+	// Restricted day fields are combined with OR, as in cron. If either field is *, only the other one is used.
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+// cronField Allowed range of a cron field
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// maxSearchYears How far into the future to look for a matching time, for expressions such as "0 0 31 2 *" that
+// never match
+const maxSearchYears = 5
+
+// ParseCron Parse a standard five field cron expression, for instance "30 2 * * 1-5". Fields support *, lists, ranges
+// and steps. Sunday is both 0 and 7 in the day of week field. Times are matched in the location of the time passed to
+// Next.
+func ParseCron(expr string) (Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("cron expression '%s' must have %d fields, has %d", expr, len(cronFields), len(fields))
+	}
+
+	values := make([]map[int]bool, len(fields))
+	for i, field := range fields {
+		parsed, err := parseCronField(field, cronFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("cron expression '%s': %w", expr, err)
+		}
+		values[i] = parsed
+	}
+
+	if values[4][7] {
+		values[4][0] = true
+	}
+
+	return &cronSchedule{
+		minutes:       values[0],
+		hours:         values[1],
+		daysOfMonth:   values[2],
+		months:        values[3],
+		daysOfWeek:    values[4],
+		anyDayOfMonth: strings.HasPrefix(fields[2], "*"),
+		anyDayOfWeek:  strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// parseCronField Parse a single field, returning the set of matching values
+func parseCronField(field string, spec cronField) (map[int]bool, error) {
+	values := make(map[int]bool)
+
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step < 1 {
+				return nil, fmt.Errorf("invalid step in %s field '%s'", spec.name, field)
+			}
+			part = part[:i]
+		}
+
+		start, end := spec.min, spec.max
+		switch {
+		case part == "*":
+		case strings.Contains(part, "-"):
+			bounds := strings.SplitN(part, "-", 2)
+			var err1, err2 error
+			start, err1 = strconv.Atoi(bounds[0])
+			end, err2 = strconv.Atoi(bounds[1])
+			if err1 != nil || err2 != nil {
+				return nil, fmt.Errorf("invalid range in %s field '%s'", spec.name, field)
+			}
+		default:
+			value, err := strconv.Atoi(part)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value in %s field '%s'", spec.name, field)
+			}
+			start = value
+			if step == 1 {
+				end = value
+			}
+		}
+
+		if start < spec.min || end > spec.max || start > end {
+			return nil, fmt.Errorf("%s field '%s' is out of range %d-%d", spec.name, field, spec.min, spec.max)
+		}
+
+		for value := start; value <= end; value += step {
+			values[value] = true
+		}
+	}
+
+	return values, nil
+}
+
+func (s *cronSchedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, loc)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if !s.months[int(t.Month())] {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.hours[t.Hour()] {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.minutes[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	dayOfMonth := s.daysOfMonth[t.Day()]
+	dayOfWeek := s.daysOfWeek[int(t.Weekday())]
+
+	switch {
+	case s.anyDayOfMonth && s.anyDayOfWeek:
+		return true
+	case s.anyDayOfMonth:
+		return dayOfWeek
+	case s.anyDayOfWeek:
+		return dayOfMonth
+	default:
+		return dayOfMonth || dayOfWeek
+	}
+}
@@ -0,0 +1,55 @@
+package resync_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nais/console/pkg/resync"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvery(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, now.Add(6*time.Hour), resync.Every(6*time.Hour).Next(now))
+}
+
+func TestParseCron(t *testing.T) {
+	// Wednesday
+	now := time.Date(2022, 6, 1, 12, 30, 15, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2022, 6, 1, 12, 31, 0, 0, time.UTC)},
+		{"30 2 * * *", time.Date(2022, 6, 2, 2, 30, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2022, 6, 1, 12, 45, 0, 0, time.UTC)},
+		{"0 9-17/4 * * *", time.Date(2022, 6, 1, 13, 0, 0, 0, time.UTC)},
+		{"0 3 * * 0", time.Date(2022, 6, 5, 3, 0, 0, 0, time.UTC)},
+		{"0 3 * * 7", time.Date(2022, 6, 5, 3, 0, 0, 0, time.UTC)},
+		{"0 0 1 */3 *", time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 15,20 * 5", time.Date(2022, 6, 3, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			schedule, err := resync.ParseCron(tt.expr)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.next, schedule.Next(now))
+		})
+	}
+
+	t.Run("never matches", func(t *testing.T) {
+		schedule, err := resync.ParseCron("0 0 31 2 *")
+		assert.NoError(t, err)
+		assert.True(t, schedule.Next(now).IsZero())
+	})
+
+	t.Run("invalid expressions", func(t *testing.T) {
+		for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+			_, err := resync.ParseCron(expr)
+			assert.Error(t, err, expr)
+		}
+	})
+}
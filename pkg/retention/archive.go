@@ -0,0 +1,56 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// ArchiveStore Storage for archived rows, for instance a local directory or an object storage bucket
+type ArchiveStore interface {
+	// Put Store an object with the given name. The name may contain slashes. An object must not be visible in the
+	// store until it has been completely written.
+	Put(ctx context.Context, name string, content io.Reader) error
+}
+
+type dirStore struct {
+	dir string
+}
+
+// NewDirStore Create an archive store that writes objects as files in a directory. Slashes in object names become
+// subdirectories.
+func NewDirStore(dir string) ArchiveStore {
+	return &dirStore{
+		dir: dir,
+	}
+}
+
+func (s *dirStore) Put(_ context.Context, name string, content io.Reader) error {
+	path := filepath.Join(s.dir, filepath.FromSlash(name))
+	err := os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so a partially written archive is never mistaken for a complete one
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".archive-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, content)
+	if err != nil {
+		tmp.Close()
+		return fmt.Errorf("write archive '%s': %w", name, err)
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
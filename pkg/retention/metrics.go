@@ -0,0 +1,42 @@
+package retention
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	metricsNamespace = "console"
+	metricsSubsystem = "retention"
+)
+
+var (
+	runsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "runs_total",
+		Help:      "Number of retention runs, by result.",
+	}, []string{"result"})
+
+	purgedRowsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "purged_rows_total",
+		Help:      "Number of rows purged by the retention job, by table.",
+	}, []string{"table"})
+
+	lastSuccess = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "last_success_timestamp_seconds",
+		Help:      "Time of the last successful retention run, as a unix timestamp.",
+	})
+
+	runDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "run_duration_seconds",
+		Help:      "Duration of retention runs.",
+		Buckets:   prometheus.ExponentialBuckets(0.1, 4, 8),
+	})
+)
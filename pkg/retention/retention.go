@@ -0,0 +1,327 @@
+package retention
+
+import (
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/config"
+	"github.com/nais/console/pkg/dbmodels"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	OpRun = "retention:run"
+)
+
+// batchSize Number of rows read from the database at a time when archiving reconcile errors
+const batchSize = 500
+
+var (
+	ErrNotEnabled = errors.New("disabled by configuration")
+)
+
+// Policy How long rows are kept before they are archived and purged. A zero duration keeps rows forever.
+type Policy struct {
+	AuditLogs       time.Duration
+	ReconcileErrors time.Duration
+}
+
+// Result Number of rows purged from each table, and the names of the archives written during a run
+type Result struct {
+	AuditLogs        int64
+	ReconcileErrors  int64
+	ReconcileResults int64
+	Correlations     int64
+	Archives         []string
+}
+
+type retention struct {
+	db          *gorm.DB
+	system      dbmodels.System
+	auditLogger auditlogger.AuditLogger
+	store       ArchiveStore
+	policy      Policy
+	now         func() time.Time
+}
+
+// reconcileErrorRecord Archived representation of a reconcile error
+type reconcileErrorRecord struct {
+	ID            uuid.UUID `json:"id"`
+	CreatedAt     time.Time `json:"created_at"`
+	CorrelationID uuid.UUID `json:"correlation_id"`
+	SystemID      uuid.UUID `json:"system_id"`
+	TeamID        uuid.UUID `json:"team_id"`
+	Message       string    `json:"message"`
+}
+
+func New(db *gorm.DB, system dbmodels.System, auditLogger auditlogger.AuditLogger, store ArchiveStore, policy Policy) *retention {
+	return &retention{
+		db:          db,
+		system:      system,
+		auditLogger: auditLogger,
+		store:       store,
+		policy:      policy,
+		now:         time.Now,
+	}
+}
+
+func NewFromConfig(cfg *config.Config, db *gorm.DB, system dbmodels.System, auditLogger auditlogger.AuditLogger) (*retention, error) {
+	if cfg.Retention.AuditLogDays < 0 || cfg.Retention.ReconcileErrorDays < 0 {
+		return nil, fmt.Errorf("retention days must not be negative")
+	}
+
+	if cfg.Retention.AuditLogDays == 0 && cfg.Retention.ReconcileErrorDays == 0 {
+		return nil, ErrNotEnabled
+	}
+
+	if cfg.Retention.ArchiveDir == "" {
+		return nil, fmt.Errorf("an archive directory is required when retention is enabled")
+	}
+
+	if cfg.Retention.Interval <= 0 {
+		return nil, fmt.Errorf("the retention interval must be positive")
+	}
+
+	policy := Policy{
+		AuditLogs:       time.Duration(cfg.Retention.AuditLogDays) * 24 * time.Hour,
+		ReconcileErrors: time.Duration(cfg.Retention.ReconcileErrorDays) * 24 * time.Hour,
+	}
+
+	return New(db, system, auditLogger, NewDirStore(cfg.Retention.ArchiveDir), policy), nil
+}
+
+// Run Archive and purge rows that are older than the policy allows, and add an audit log entry summarising the run
+func (r *retention) Run(ctx context.Context) (*Result, error) {
+	start := r.now()
+	result, err := r.run(ctx, start)
+	runDuration.Observe(time.Since(start).Seconds())
+
+	purgedRowsTotal.WithLabelValues("audit_logs").Add(float64(result.AuditLogs))
+	purgedRowsTotal.WithLabelValues("reconcile_errors").Add(float64(result.ReconcileErrors))
+	purgedRowsTotal.WithLabelValues("reconcile_results").Add(float64(result.ReconcileResults))
+	purgedRowsTotal.WithLabelValues("correlations").Add(float64(result.Correlations))
+
+	summary := fmt.Sprintf("archived and purged %d audit log entries and %d reconcile errors, and purged %d reconcile results and %d correlations", result.AuditLogs, result.ReconcileErrors, result.ReconcileResults, result.Correlations)
+	if err != nil {
+		runsTotal.WithLabelValues("error").Inc()
+		r.logSummary(ctx, "Retention run failed after it %s: %s", summary, err)
+		return result, err
+	}
+
+	runsTotal.WithLabelValues("success").Inc()
+	lastSuccess.SetToCurrentTime()
+	r.logSummary(ctx, "Retention run %s", summary)
+
+	return result, nil
+}
+
+func (r *retention) run(ctx context.Context, now time.Time) (*Result, error) {
+	result := &Result{
+		Archives: make([]string, 0),
+	}
+	suffix := now.UTC().Format("20060102T150405Z")
+
+	if r.policy.AuditLogs > 0 {
+		cutoff := now.Add(-r.policy.AuditLogs)
+		name := "audit_logs/" + suffix + ".ndjson.gz"
+		purged, err := r.purgeAuditLogs(ctx, cutoff, name)
+		if err != nil {
+			return result, fmt.Errorf("%s: purge audit logs: %w", OpRun, err)
+		}
+		if purged > 0 {
+			result.Archives = append(result.Archives, name)
+		}
+		result.AuditLogs = purged
+	}
+
+	if r.policy.ReconcileErrors > 0 {
+		cutoff := now.Add(-r.policy.ReconcileErrors)
+		name := "reconcile_errors/" + suffix + ".ndjson.gz"
+		purged, err := r.purgeReconcileErrors(ctx, cutoff, name)
+		if err != nil {
+			return result, fmt.Errorf("%s: purge reconcile errors: %w", OpRun, err)
+		}
+		if purged > 0 {
+			result.Archives = append(result.Archives, name)
+		}
+		result.ReconcileErrors = purged
+
+		purged, err = r.purgeReconcileResults(ctx, cutoff)
+		if err != nil {
+			return result, fmt.Errorf("%s: purge reconcile results: %w", OpRun, err)
+		}
+		result.ReconcileResults = purged
+	}
+
+	if r.policy.AuditLogs > 0 {
+		cutoff := now.Add(-r.policy.AuditLogs)
+		purged, err := r.purgeCorrelations(ctx, cutoff)
+		if err != nil {
+			return result, fmt.Errorf("%s: purge correlations: %w", OpRun, err)
+		}
+		result.Correlations = purged
+	}
+
+	return result, nil
+}
+
+// purgeAuditLogs Archive and purge audit log entries created before the cutoff. Entries are purged from the start of
+// the hash chain, and the newest entry is always kept so the chain can be continued.
+func (r *retention) purgeAuditLogs(ctx context.Context, cutoff time.Time, name string) (int64, error) {
+	db := r.db.WithContext(ctx)
+
+	var last, upTo sql.NullInt64
+	err := db.Model(&dbmodels.AuditLog{}).Select("MAX(sequence)").Row().Scan(&last)
+	if err != nil {
+		return 0, err
+	}
+
+	err = db.Model(&dbmodels.AuditLog{}).Where("created_at < ?", cutoff).Select("MAX(sequence)").Row().Scan(&upTo)
+	if err != nil {
+		return 0, err
+	}
+
+	if !upTo.Valid {
+		return 0, nil
+	}
+
+	if upTo.Int64 >= last.Int64 {
+		upTo.Int64 = last.Int64 - 1
+	}
+
+	count := int64(0)
+	err = db.Model(&dbmodels.AuditLog{}).Where("sequence <= ?", upTo.Int64).Count(&count).Error
+	if err != nil || count == 0 {
+		return 0, err
+	}
+
+	err = r.archive(ctx, name, func(w io.Writer) error {
+		return auditlogger.ExportScoped(db, w, auditlogger.ExportFormatNDJSON, func(db *gorm.DB) *gorm.DB {
+			return db.Where("sequence <= ?", upTo.Int64)
+		})
+	})
+	if err != nil {
+		return 0, fmt.Errorf("archive: %w", err)
+	}
+
+	purged := int64(0)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if tx.Dialector.Name() == "postgres" {
+			err := tx.Exec("SET LOCAL console.audit_log_retention = 'on'").Error
+			if err != nil {
+				return err
+			}
+		}
+
+		// Raw SQL, as deleting audit log entries through the model is blocked
+		res := tx.Exec("DELETE FROM audit_logs WHERE sequence <= ?", upTo.Int64)
+		purged = res.RowsAffected
+		return res.Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+// purgeReconcileErrors Archive and purge reconcile errors created before the cutoff, including soft deleted ones
+func (r *retention) purgeReconcileErrors(ctx context.Context, cutoff time.Time, name string) (int64, error) {
+	db := r.db.WithContext(ctx)
+
+	count := int64(0)
+	err := db.Unscoped().Model(&dbmodels.ReconcileError{}).Where("created_at < ?", cutoff).Count(&count).Error
+	if err != nil || count == 0 {
+		return 0, err
+	}
+
+	err = r.archive(ctx, name, func(w io.Writer) error {
+		enc := json.NewEncoder(w)
+		rows := make([]*dbmodels.ReconcileError, 0)
+		return db.Unscoped().Where("created_at < ?", cutoff).FindInBatches(&rows, batchSize, func(_ *gorm.DB, _ int) error {
+			for _, row := range rows {
+				err := enc.Encode(reconcileErrorRecord{
+					ID:            *row.ID,
+					CreatedAt:     row.CreatedAt,
+					CorrelationID: row.CorrelationID,
+					SystemID:      row.SystemID,
+					TeamID:        row.TeamID,
+					Message:       row.Message,
+				})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("archive: %w", err)
+	}
+
+	res := db.Exec("DELETE FROM reconcile_errors WHERE created_at < ?", cutoff)
+	return res.RowsAffected, res.Error
+}
+
+// purgeReconcileResults Purge reconcile results created before the cutoff. Results only record whether a reconciler
+// succeeded, and the errors are archived separately, so they are not archived.
+func (r *retention) purgeReconcileResults(ctx context.Context, cutoff time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).Exec("DELETE FROM reconcile_results WHERE created_at < ?", cutoff)
+	return res.RowsAffected, res.Error
+}
+
+// purgeCorrelations Purge correlations created before the cutoff that are no longer referenced. Correlations only
+// contain an ID and timestamps, so they are not archived.
+func (r *retention) purgeCorrelations(ctx context.Context, cutoff time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).Exec(`DELETE FROM correlations
+WHERE created_at < ?
+  AND NOT EXISTS (SELECT 1 FROM audit_logs WHERE audit_logs.correlation_id = correlations.id)
+  AND NOT EXISTS (SELECT 1 FROM reconcile_errors WHERE reconcile_errors.correlation_id = correlations.id)
+  AND NOT EXISTS (SELECT 1 FROM reconcile_results WHERE reconcile_results.correlation_id = correlations.id)
+  AND NOT EXISTS (SELECT 1 FROM reconcile_queue_entries WHERE reconcile_queue_entries.correlation_id = correlations.id)`, cutoff)
+	return res.RowsAffected, res.Error
+}
+
+// archive Store the output of write as a gzip compressed object in the archive store
+func (r *retention) archive(ctx context.Context, name string, write func(w io.Writer) error) error {
+	pr, pw := io.Pipe()
+	go func() {
+		gz := gzip.NewWriter(pw)
+		err := write(gz)
+		if err == nil {
+			err = gz.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	err := r.store.Put(ctx, name, pr)
+
+	// Unblock the writer if the store gave up before reading everything
+	pr.CloseWithError(err)
+	return err
+}
+
+func (r *retention) logSummary(ctx context.Context, message string, args ...interface{}) {
+	corr := &dbmodels.Correlation{
+		Trigger: dbmodels.CorrelationTriggerRetention,
+	}
+	err := r.db.WithContext(ctx).Create(corr).Error
+	if err != nil {
+		log.Warnf("unable to create correlation for retention audit log: %s", err)
+		return
+	}
+
+	err = r.auditLogger.Logf(OpRun, *corr, r.system, nil, nil, nil, message, args...)
+	if err != nil {
+		log.Warnf("unable to add retention summary to the audit log: %s", err)
+	}
+}
@@ -0,0 +1,167 @@
+package retention_test
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nais/console/pkg/auditlogger"
+	"github.com/nais/console/pkg/config"
+	"github.com/nais/console/pkg/dbmodels"
+	"github.com/nais/console/pkg/retention"
+	"github.com/nais/console/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func setup(t *testing.T, entries int) (*gorm.DB, dbmodels.System, dbmodels.Team) {
+	db := test.GetTestDB()
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	db.AutoMigrate(&dbmodels.User{}, &dbmodels.Team{}, &dbmodels.System{}, &dbmodels.Correlation{}, &dbmodels.AuditLog{}, &dbmodels.ReconcileError{}, &dbmodels.ReconcileResult{}, &dbmodels.ReconcileQueueEntry{})
+
+	system := dbmodels.System{Name: "console"}
+	team := dbmodels.Team{Slug: "team", Name: "Team"}
+	db.Create(&system)
+	db.Create(&team)
+
+	logger := auditlogger.New(db)
+	for i := 0; i < entries; i++ {
+		corr := &dbmodels.Correlation{}
+		db.Create(corr)
+		err := logger.Logf("action", *corr, system, nil, &team, nil, "entry %d", i)
+		assert.NoError(t, err)
+	}
+
+	return db, system, team
+}
+
+// backdate Move rows into the past, bypassing hooks. Audit log entries that are moved are purged during the test, so
+// their hashes don't matter.
+func backdate(db *gorm.DB, table, where string, args ...interface{}) {
+	old := time.Now().Add(-100 * 24 * time.Hour)
+	db.Exec("UPDATE "+table+" SET created_at = ? WHERE "+where, append([]interface{}{old}, args...)...)
+}
+
+func countLines(t *testing.T, path string) int {
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	assert.NoError(t, err)
+
+	lines := 0
+	scanner := bufio.NewScanner(gz)
+	for scanner.Scan() {
+		lines++
+	}
+	assert.NoError(t, scanner.Err())
+	return lines
+}
+
+func TestRetention(t *testing.T) {
+	ctx := context.Background()
+	policy := retention.Policy{
+		AuditLogs:       90 * 24 * time.Hour,
+		ReconcileErrors: 30 * 24 * time.Hour,
+	}
+
+	t.Run("Archive and purge old rows", func(t *testing.T) {
+		db, system, team := setup(t, 5)
+		backdate(db, "audit_logs", "sequence <= ?", 3)
+		backdate(db, "correlations", "1 = 1")
+
+		for _, message := range []string{"old", "new"} {
+			corr := &dbmodels.Correlation{}
+			db.Create(corr)
+			db.Create(&dbmodels.ReconcileError{CorrelationID: *corr.ID, SystemID: *system.ID, TeamID: *team.ID, Message: message})
+			db.Create(&dbmodels.ReconcileResult{CorrelationID: *corr.ID, SystemID: *system.ID, TeamID: *team.ID, Message: message})
+		}
+		backdate(db, "reconcile_errors", "message = ?", "old")
+		backdate(db, "reconcile_results", "message = ?", "old")
+
+		dir := t.TempDir()
+		r := retention.New(db, system, auditlogger.New(db), retention.NewDirStore(dir), policy)
+		result, err := r.Run(ctx)
+		assert.NoError(t, err)
+
+		assert.Equal(t, int64(3), result.AuditLogs)
+		assert.Equal(t, int64(1), result.ReconcileErrors)
+		assert.Equal(t, int64(1), result.ReconcileResults)
+		assert.Equal(t, int64(3), result.Correlations)
+		assert.Len(t, result.Archives, 2)
+
+		assert.Equal(t, 3, countLines(t, filepath.Join(dir, result.Archives[0])))
+		assert.Equal(t, 1, countLines(t, filepath.Join(dir, result.Archives[1])))
+
+		remaining := make([]*dbmodels.AuditLog, 0)
+		db.Order("sequence").Find(&remaining)
+		assert.Len(t, remaining, 3)
+		assert.Equal(t, int64(4), remaining[0].Sequence)
+		assert.Equal(t, retention.OpRun, remaining[2].Action)
+
+		verification, err := auditlogger.VerifyChain(db)
+		assert.NoError(t, err)
+		assert.True(t, verification.Valid())
+		assert.Equal(t, int64(4), verification.FirstSequence)
+
+		errors := make([]*dbmodels.ReconcileError, 0)
+		db.Find(&errors)
+		assert.Len(t, errors, 1)
+		assert.Equal(t, "new", errors[0].Message)
+	})
+
+	t.Run("Newest audit log entry is kept", func(t *testing.T) {
+		db, system, _ := setup(t, 2)
+		backdate(db, "audit_logs", "1 = 1")
+
+		r := retention.New(db, system, auditlogger.New(db), retention.NewDirStore(t.TempDir()), policy)
+		result, err := r.Run(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), result.AuditLogs)
+
+		remaining := make([]*dbmodels.AuditLog, 0)
+		db.Order("sequence").Find(&remaining)
+		assert.Len(t, remaining, 2)
+		assert.Equal(t, int64(2), remaining[0].Sequence)
+		assert.Equal(t, int64(3), remaining[1].Sequence)
+	})
+
+	t.Run("Nothing to purge", func(t *testing.T) {
+		db, system, _ := setup(t, 2)
+		dir := t.TempDir()
+
+		r := retention.New(db, system, auditlogger.New(db), retention.NewDirStore(dir), policy)
+		result, err := r.Run(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), result.AuditLogs)
+		assert.Empty(t, result.Archives)
+
+		files, _ := os.ReadDir(dir)
+		assert.Empty(t, files)
+	})
+}
+
+func TestNewFromConfig(t *testing.T) {
+	cfg := &config.Config{
+		Retention: config.Retention{
+			AuditLogDays: 90,
+			ArchiveDir:   t.TempDir(),
+			Interval:     24 * time.Hour,
+		},
+	}
+
+	_, err := retention.NewFromConfig(cfg, nil, dbmodels.System{}, nil)
+	assert.NoError(t, err)
+
+	for _, interval := range []time.Duration{0, -time.Hour} {
+		cfg.Retention.Interval = interval
+		_, err = retention.NewFromConfig(cfg, nil, dbmodels.System{}, nil)
+		assert.EqualError(t, err, "the retention interval must be positive")
+	}
+}
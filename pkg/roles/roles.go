@@ -5,6 +5,7 @@ type Role string
 
 const (
 	AuthorizationAuditLogsRead         Authorization = "audit_logs.read"
+	AuthorizationRepositoriesManage    Authorization = "repositories.manage"
 	AuthorizationServiceAccountsCreate Authorization = "service_accounts.create"
 	AuthorizationServiceAccountsDelete Authorization = "service_accounts.delete"
 	AuthorizationServiceAccountList    Authorization = "service_accounts.list"
@@ -28,3 +29,56 @@ const (
 	RoleTeamViewer            Role = "Team viewer"
 	RoleUserViewer            Role = "User viewer"
 )
+
+// Authorizations All authorizations known to Console. Authorizations are synchronized to the database on startup.
+var Authorizations = []Authorization{
+	AuthorizationAuditLogsRead,
+	AuthorizationRepositoriesManage,
+	AuthorizationServiceAccountsCreate,
+	AuthorizationServiceAccountsDelete,
+	AuthorizationServiceAccountList,
+	AuthorizationServiceAccountsUpdate,
+	AuthorizationSystemStatesDelete,
+	AuthorizationSystemStatesRead,
+	AuthorizationSystemStatesUpdate,
+	AuthorizationTeamsCreate,
+	AuthorizationTeamsDelete,
+	AuthorizationTeamsList,
+	AuthorizationTeamsRead,
+	AuthorizationTeamsUpdate,
+	AuthorizationUsersList,
+}
+
+// Roles All roles known to Console, along with the authorizations granted by each role. Roles and their
+// authorizations are synchronized to the database on startup.
+var Roles = map[Role][]Authorization{
+	RoleAdmin: Authorizations,
+	RoleServiceAccountCreator: {
+		AuthorizationServiceAccountsCreate,
+	},
+	RoleServiceAccountOwner: {
+		AuthorizationServiceAccountsDelete,
+		AuthorizationServiceAccountsUpdate,
+	},
+	RoleTeamCreator: {
+		AuthorizationTeamsCreate,
+	},
+	RoleTeamMember: {
+		AuthorizationTeamsRead,
+		AuthorizationAuditLogsRead,
+	},
+	RoleTeamOwner: {
+		AuthorizationTeamsDelete,
+		AuthorizationTeamsRead,
+		AuthorizationTeamsUpdate,
+		AuthorizationAuditLogsRead,
+	},
+	RoleTeamViewer: {
+		AuthorizationTeamsList,
+		AuthorizationTeamsRead,
+		AuthorizationAuditLogsRead,
+	},
+	RoleUserViewer: {
+		AuthorizationUsersList,
+	},
+}
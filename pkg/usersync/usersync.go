@@ -84,7 +84,9 @@ func (s *userSynchronizer) Sync(ctx context.Context) error {
 		return fmt.Errorf("%s: list remote users: %w", OpListRemote, err)
 	}
 
-	corr := &dbmodels.Correlation{}
+	corr := &dbmodels.Correlation{
+		Trigger: dbmodels.CorrelationTriggerUserSync,
+	}
 	err = s.db.Create(corr).Error
 	if err != nil {
 		return fmt.Errorf("%s: unable to create correlation for audit logs: %w", OpPrepare, err)